@@ -30,7 +30,7 @@ func init() {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:printcolumn:name="SCHEDULE",type=string,JSONPath=`.spec.schedule`
+// +kubebuilder:printcolumn:name="SCHEDULE",type=string,JSONPath=`.status.schedule`
 // +kubebuilder:printcolumn:name="SUSPEND",type=boolean,JSONPath=`.spec.suspend`
 // +kubebuilder:printcolumn:name="LAST_SCHEDULE",type=string,JSONPath=`.status.lastScheduleTime`
 // +kubebuilder:printcolumn:name="AGE",type=date,JSONPath=`.metadata.creationTimestamp`
@@ -69,12 +69,62 @@ type CronList struct {
 }
 
 // CronSpec defines the desired state of Cron.
+// +kubebuilder:validation:XValidation:rule="has(self.schedule) != has(self.schedules)",message="exactly one of schedule and schedules must be specified"
+// +kubebuilder:validation:XValidation:rule="!has(self.startTime) || !has(self.deadline) || self.deadline > self.startTime",message="deadline must be after startTime"
+// +kubebuilder:validation:XValidation:rule="!has(self.maxActiveRuns) || !has(self.concurrencyPolicy) || self.concurrencyPolicy == 'Allow'",message="maxActiveRuns may only be specified with the Allow concurrency policy"
+// +kubebuilder:validation:XValidation:rule="!has(self.concurrencyGroupLimit) || has(self.concurrencyGroup)",message="concurrencyGroupLimit may only be specified with a concurrency group"
 type CronSpec struct {
 	// Schedule specifies the cron schedule in standard cron format.
 	// For example: "0 0 * * *" for daily at midnight, "*/5 * * * *" for every 5 minutes.
 	// See https://en.wikipedia.org/wiki/Cron for more details.
-	// +required
-	Schedule string `json:"schedule"`
+	// Exactly one of Schedule and Schedules must be specified.
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+
+	// Schedules specifies multiple cron schedules, e.g. for runs at different times on weekdays and weekends.
+	// The Cron runs at the combined times of all schedules, and each schedule is tracked and
+	// its concurrency policy applied independently, so that schedules firing at the same time
+	// neither collide nor cancel each other.
+	// Exactly one of Schedule and Schedules must be specified.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	Schedules []CronSchedule `json:"schedules,omitempty"`
+
+	// ScheduleFormat specifies the format of the cron expressions in Schedule and Schedules.
+	// Valid values are:
+	// - "Standard" (default): the standard five-field format, e.g. "0 0 * * *". Descriptors such as
+	//   "@hourly" and intervals such as "@every 90s" are accepted as well for backward compatibility.
+	// - "WithSeconds": the standard format with an optional leading seconds field, e.g. "30 0 0 * * *".
+	// +optional
+	// +kubebuilder:default=Standard
+	ScheduleFormat ScheduleFormat `json:"scheduleFormat,omitempty"`
+
+	// TimeZone is the name of the time zone in which the schedule is evaluated,
+	// e.g. "Asia/Shanghai" or "America/New_York". It must be a valid name from the
+	// IANA time zone database. If not specified, the local time zone of the controller is used.
+	// Schedule times are wall-clock times in this zone, so across daylight saving transitions:
+	// - a time skipped by a forward transition (e.g. 02:30 when clocks jump from 02:00 to 03:00)
+	//   does not occur and is not scheduled on that day.
+	// - a time repeated by a backward transition (e.g. 01:30 when clocks fall back from 02:00 to 01:00)
+	//   is only scheduled once, at its first occurrence.
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
+
+	// Jitter is the maximum delay of runs after their scheduled times, used to spread out the runs
+	// of Crons sharing the same schedule. The runs of each Cron are delayed by a stable offset
+	// within the jitter derived from its UID, and workloads are still named after their scheduled times.
+	// It should be smaller than the interval between scheduled times.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('0s')",message="must be non-negative"
+	Jitter *metav1.Duration `json:"jitter,omitempty"`
+
+	// CalendarRefs references CronCalendars in the same namespace whose excluded dates and time windows
+	// block runs of the Cron. Blocked runs are skipped and recorded in status. No runs are created while
+	// a referenced calendar is missing or invalid.
+	// +optional
+	// +listType=atomic
+	CalendarRefs []corev1.LocalObjectReference `json:"calendarRefs,omitempty"`
 
 	// Template specifies the workload template that will be created when executing a cron job.
 	// +required
@@ -85,21 +135,119 @@ type CronSpec struct {
 	// - "Allow" (default): allows cron jobs to run concurrently.
 	// - "Forbid": forbids concurrent runs, skipping next run if previous run hasn't finished yet.
 	// - "Replace": cancels currently running job and replaces it with a new one.
+	// - "Queue": forbids concurrent runs, queueing next run in status and creating it once previous run has finished.
 	// +optional
 	// +kubebuilder:default=Allow
 	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
 
+	// MaxQueuedRuns is the maximum number of runs of each schedule queued with the Queue concurrency policy.
+	// Runs scheduled while the queue is full are skipped and recorded in status.
+	// Defaults to 10.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxQueuedRuns *int32 `json:"maxQueuedRuns,omitempty"`
+
+	// ConcurrencyGroup is the name of a group of Crons in the same namespace whose runs are limited together,
+	// e.g. Crons training on the same dataset shard. A run is only created while fewer than ConcurrencyGroupLimit
+	// runs of the Crons in the group are active, and waits until one of them has finished otherwise.
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	ConcurrencyGroup string `json:"concurrencyGroup,omitempty"`
+
+	// ConcurrencyGroupLimit is the maximum number of active runs of the Crons in the concurrency group.
+	// Defaults to 1, which means the runs of the group never overlap.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	ConcurrencyGroupLimit *int32 `json:"concurrencyGroupLimit,omitempty"`
+
+	// ReplacePolicy specifies how active runs are replaced with the Replace concurrency policy.
+	// Replaced runs are deleted, and the new run is only created once they and their pods are gone,
+	// so that they do not compete for the same resources.
+	// +optional
+	ReplacePolicy *CronReplacePolicy `json:"replacePolicy,omitempty"`
+
+	// MaxActiveRuns is the maximum number of active runs with the Allow concurrency policy,
+	// e.g. 3 to let up to three runs overlap. Like the concurrency policy, it applies to the runs
+	// of each schedule independently. If not specified, the number of active runs is unlimited.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxActiveRuns *int32 `json:"maxActiveRuns,omitempty"`
+
+	// MaxActiveRunsPolicy specifies how to treat a new run when MaxActiveRuns runs are active.
+	// Valid values are:
+	// - "Skip" (default): the new run is skipped and recorded in status.
+	// - "ReplaceOldest": the oldest active run is deleted to make room for the new run.
+	// +optional
+	// +kubebuilder:default=Skip
+	MaxActiveRunsPolicy MaxActiveRunsPolicy `json:"maxActiveRunsPolicy,omitempty"`
+
 	// Suspend tells the controller to suspend subsequent executions.
 	// It does not apply to already started executions.
+	// Runs triggered on demand while suspended are skipped and recorded in status.
 	// Defaults to false.
 	// +optional
 	Suspend *bool `json:"suspend,omitempty"`
 
+	// StartTime is the optional timestamp before which the cron job will not schedule any executions.
+	// If specified, no jobs will be created before this time, and runs scheduled before it are not caught up.
+	// Together with Deadline, it declares the time window in which the cron job is scheduled.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
 	// Deadline is the optional deadline timestamp after which the cron job will stop scheduling new executions.
 	// If specified, no new jobs will be created after this time.
 	// +optional
 	Deadline *metav1.Time `json:"deadline,omitempty"`
 
+	// StartingDeadlineSeconds is the optional deadline in seconds for starting a run after its scheduled time.
+	// A run that is not started within this deadline, e.g. because the controller was down, is skipped,
+	// and missed runs are only looked for within this window. Only the latest skipped run is recorded in status.
+	// If not specified, missed runs are looked for since the last schedule time and are never skipped.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	StartingDeadlineSeconds *int64 `json:"startingDeadlineSeconds,omitempty"`
+
+	// CatchUpPolicy specifies how to treat runs that were missed, e.g. because the controller was down.
+	// Valid values are:
+	// - "Latest" (default): only the most recent missed run is created.
+	// - "All": one workload is created for each missed run, up to MaxCatchUpRuns of the most recent ones.
+	//   With the Forbid concurrency policy missed runs are created one at a time, oldest first,
+	//   and with the Replace concurrency policy only the most recent missed run is created.
+	// - "None": missed runs are skipped and recorded in status, and the next scheduled run is waited for.
+	// +optional
+	// +kubebuilder:default=Latest
+	CatchUpPolicy CatchUpPolicy `json:"catchUpPolicy,omitempty"`
+
+	// MaxCatchUpRuns is the maximum number of missed runs that are created when CatchUpPolicy is All.
+	// Older missed runs beyond this limit are skipped and recorded in status.
+	// Defaults to 10.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxCatchUpRuns *int32 `json:"maxCatchUpRuns,omitempty"`
+
+	// MaxRuns is the optional maximum number of runs the cron job will schedule in total.
+	// Once this many runs have been scheduled, the cron job stops scheduling new executions.
+	// Runs triggered on demand are counted as well, and are skipped once the maximum has been reached.
+	// Retries of failed runs are not counted.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxRuns *int32 `json:"maxRuns,omitempty"`
+
+	// RunTimeout is the maximum duration of a run since its workload was created. Active workloads running
+	// longer are stopped and recorded as TimedOut in history: workloads supporting suspension, e.g. Kubeflow
+	// jobs, batch Jobs and JobSets, are suspended, and other workloads are deleted.
+	// If not specified, runs are never stopped.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) > duration('0s')",message="must be positive"
+	RunTimeout *metav1.Duration `json:"runTimeout,omitempty"`
+
+	// RetryPolicy specifies how failed runs are retried with an exponential backoff.
+	// Retries are runs of the same scheduled time following the concurrency policy, and a failed run
+	// is no longer retried once a later run of its schedule has been created.
+	// If not specified, failed runs are not retried.
+	// +optional
+	RetryPolicy *CronRetryPolicy `json:"retryPolicy,omitempty"`
+
 	// HistoryLimit specifies the number of finished job history records to retain.
 	// This is a pointer to distinguish between explicit zero and not specified.
 	// If not set, a default value will be used by the controller.
@@ -107,6 +255,71 @@ type CronSpec struct {
 	HistoryLimit *int `json:"historyLimit,omitempty"`
 }
 
+// CronReplacePolicy describes how active runs of a Cron are replaced.
+type CronReplacePolicy struct {
+	// GracePeriodSeconds is the duration in seconds the pods of the replaced runs are given to terminate.
+	// It applies to the pods created from the pod templates of the workload template, which carry the
+	// Cron name label. If not specified, the termination grace period of the pods is used.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	GracePeriodSeconds *int64 `json:"gracePeriodSeconds,omitempty"`
+
+	// Timeout is the maximum duration to wait for the replaced runs and their pods to be gone,
+	// after which the new run is created anyway.
+	// Defaults to 10m.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) > duration('0s')",message="must be positive"
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// CronRetryPolicy describes how failed runs of a Cron are retried.
+type CronRetryPolicy struct {
+	// MaxRetries is the maximum number of times a failed run is retried.
+	// +required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	MaxRetries int32 `json:"maxRetries"`
+
+	// Backoff is the delay after a failure before the first retry, doubled for each subsequent retry.
+	// Defaults to 10s.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) > duration('0s')",message="must be positive"
+	Backoff *metav1.Duration `json:"backoff,omitempty"`
+
+	// MaxBackoff is the maximum delay after a failure before a retry.
+	// Defaults to 1h.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) > duration('0s')",message="must be positive"
+	MaxBackoff *metav1.Duration `json:"maxBackoff,omitempty"`
+}
+
+// CronSchedule is one of multiple schedules of a Cron.
+type CronSchedule struct {
+	// Name is the optional name of the schedule, which must be unique within the Cron.
+	// It is used in the names of the workloads created by the schedule.
+	// If not specified, the index of the schedule in the list is used instead.
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name,omitempty"`
+
+	// Schedule specifies the cron schedule in standard cron format.
+	// +required
+	Schedule string `json:"schedule"`
+}
+
+// ScheduleFormat describes the format of cron expressions.
+// +kubebuilder:validation:Enum=Standard;WithSeconds
+type ScheduleFormat string
+
+const (
+	// ScheduleFormatStandard is the standard five-field cron format, which also accepts descriptors and intervals.
+	ScheduleFormatStandard ScheduleFormat = "Standard"
+
+	// ScheduleFormatWithSeconds is the standard cron format with an optional leading seconds field.
+	ScheduleFormatWithSeconds ScheduleFormat = "WithSeconds"
+)
+
 // CronTemplateSpec describes a template for launching a specific workload.
 type CronTemplateSpec struct {
 	metav1.TypeMeta `json:",inline"`
@@ -116,12 +329,42 @@ type CronTemplateSpec struct {
 	// The workload is stored as RawExtension to support different resource types.
 	// +kubebuilder:pruning:PreserveUnknownFields
 	Workload *runtime.RawExtension `json:"workload,omitempty"`
+
+	// StatusRules specifies how the status of the workload is interpreted, overriding the status rules
+	// of its kind in the operator configuration and the built-in interpretation of its kind.
+	// +optional
+	StatusRules *WorkloadStatusRules `json:"statusRules,omitempty"`
+}
+
+// WorkloadStatusRules describes how the status of a workload is interpreted with CEL expressions,
+// which are evaluated with the workload available as `self`, e.g. `self.status.phase == "Done"`.
+// Fields that may be absent should be guarded with `has()`, as evaluating them fails otherwise.
+type WorkloadStatusRules struct {
+	// Succeeded is a boolean expression which is true once the workload has succeeded.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Succeeded string `json:"succeeded"`
+
+	// Failed is a boolean expression which is true once the workload has failed.
+	// It takes precedence over Succeeded when both are true.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Failed string `json:"failed"`
+
+	// Running is an optional boolean expression which is true while the workload is running.
+	// Workloads that have neither finished nor are running are considered created.
+	// +optional
+	Running string `json:"running,omitempty"`
+
+	// Message is an optional string expression returning a human readable message about the status.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // ConcurrencyPolicy describes how concurrent executions of a job will be handled.
 // Only one of the following concurrent policies may be specified.
 // If none of the following policies is specified, the default one is Allow.
-// +kubebuilder:validation:Enum=Allow;Forbid;Replace
+// +kubebuilder:validation:Enum=Allow;Forbid;Replace;Queue
 type ConcurrencyPolicy string
 
 const (
@@ -136,6 +379,39 @@ const (
 	// ConcurrentPolicyReplace cancels the currently running job and replaces it with a new one.
 	// This ensures only one job instance runs at a time by terminating the old one.
 	ConcurrentPolicyReplace ConcurrencyPolicy = "Replace"
+
+	// ConcurrentPolicyQueue forbids concurrent runs without skipping any.
+	// If the previous run hasn't finished yet, the next scheduled run will be queued and created once it has.
+	ConcurrentPolicyQueue ConcurrencyPolicy = "Queue"
+)
+
+// MaxActiveRunsPolicy describes how a new run will be handled when the maximum number of runs are active.
+// +kubebuilder:validation:Enum=Skip;ReplaceOldest
+type MaxActiveRunsPolicy string
+
+const (
+	// MaxActiveRunsPolicySkip skips the new run.
+	MaxActiveRunsPolicySkip MaxActiveRunsPolicy = "Skip"
+
+	// MaxActiveRunsPolicyReplaceOldest deletes the oldest active run to make room for the new run.
+	MaxActiveRunsPolicyReplaceOldest MaxActiveRunsPolicy = "ReplaceOldest"
+)
+
+// CatchUpPolicy describes how runs that were missed will be handled.
+// Only one of the following catch-up policies may be specified.
+// If none of the following policies is specified, the default one is Latest.
+// +kubebuilder:validation:Enum=Latest;All;None
+type CatchUpPolicy string
+
+const (
+	// CatchUpPolicyLatest only creates the most recent missed run.
+	CatchUpPolicyLatest CatchUpPolicy = "Latest"
+
+	// CatchUpPolicyAll creates one workload for each missed run, up to a limit.
+	CatchUpPolicyAll CatchUpPolicy = "All"
+
+	// CatchUpPolicyNone never creates missed runs and waits for the next scheduled run.
+	CatchUpPolicyNone CatchUpPolicy = "None"
 )
 
 // CronStatus defines the observed state of Cron.
@@ -145,6 +421,17 @@ type CronStatus struct {
 	// +listType=atomic
 	Active []corev1.ObjectReference `json:"active,omitempty"`
 
+	// Replacing contains a list of references to the jobs being deleted with the Replace concurrency policy.
+	// The new run is created once they and their pods are gone.
+	// +optional
+	// +listType=atomic
+	Replacing []corev1.ObjectReference `json:"replacing,omitempty"`
+
+	// ConcurrencyGroupHolders contains the names of the Crons of the concurrency group with active runs.
+	// +optional
+	// +listType=atomic
+	ConcurrencyGroupHolders []string `json:"concurrencyGroupHolders,omitempty"`
+
 	// History is a list of previously scheduled cron jobs with their execution records.
 	// This provides an audit trail of job executions.
 	// +optional
@@ -153,10 +440,219 @@ type CronStatus struct {
 
 	// LastScheduleTime records the last time a job was successfully scheduled.
 	// This is used to determine the next execution time.
+	// With multiple schedules, it is the latest one of all schedules.
 	// +optional
 	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+
+	// RunCount is the total number of runs scheduled or triggered by the cron. Unlike History,
+	// it is never trimmed, and it is compared with MaxRuns.
+	// +optional
+	RunCount int64 `json:"runCount,omitempty"`
+
+	// LastTriggerToken is the last token of the trigger annotation that has been handled,
+	// so that each token triggers at most one run.
+	// +optional
+	LastTriggerToken string `json:"lastTriggerToken,omitempty"`
+
+	// Schedules records the status of each schedule when multiple schedules are specified.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Schedules []CronScheduleStatus `json:"schedules,omitempty"`
+
+	// Schedule is the normalized cron expression of the schedule, or of all schedules separated
+	// by semicolons when multiple schedules are specified.
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+
+	// TimeZone is the name of the time zone in which the schedule is currently evaluated.
+	// "Local" means the local time zone of the controller.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// QueuedRuns is the list of scheduled runs waiting for the active run of their schedule to finish
+	// with the Queue concurrency policy, oldest first.
+	// +optional
+	// +listType=atomic
+	QueuedRuns []CronQueuedRun `json:"queuedRuns,omitempty"`
+
+	// SkippedRuns is a list of the most recent scheduled runs that were skipped without creating a workload.
+	// +optional
+	// +listType=atomic
+	SkippedRuns []CronSkippedRun `json:"skippedRuns,omitempty"`
+
+	// Conditions represent the latest available observations of the state of the cron.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
+const (
+	// CronConditionScheduling indicates whether the cron is scheduling new runs.
+	// It is False when the cron is suspended, waiting for its start time, past its deadline
+	// or has reached its maximum number of runs.
+	CronConditionScheduling = "Scheduling"
+
+	// CronConditionComplete indicates the cron will not schedule any new runs unless its spec is changed,
+	// because it is past its deadline or has reached its maximum number of runs.
+	CronConditionComplete = "Complete"
+
+	// CronConditionReplacing indicates the cron is waiting for replaced runs to terminate before creating a new run.
+	CronConditionReplacing = "Replacing"
+
+	// CronConditionWorkloadKindAvailable indicates whether the kind of the workload in the template is served
+	// by the API server. It is False when the CRD of the kind is not installed, in which case no runs are created.
+	CronConditionWorkloadKindAvailable = "WorkloadKindAvailable"
+
+	// CronConditionCalendarAvailable indicates whether the calendars referenced by the cron exist and are valid.
+	// It is False when one of them is missing or invalid, in which case no runs are created until it is available,
+	// so that runs are never created in time windows it may exclude.
+	CronConditionCalendarAvailable = "CalendarAvailable"
+
+	// CronConditionInvalidSchedule indicates a schedule or the time zone of the cron cannot be parsed, which is
+	// only possible without the validating webhook. No runs are created until the spec is fixed.
+	CronConditionInvalidSchedule = "InvalidSchedule"
+)
+
+const (
+	// CronReasonScheduling means the cron is scheduling new runs.
+	CronReasonScheduling = "Scheduling"
+
+	// CronReasonSuspended means the cron is suspended.
+	CronReasonSuspended = "Suspended"
+
+	// CronReasonWaitingForStartTime means the cron has not reached its start time yet.
+	CronReasonWaitingForStartTime = "WaitingForStartTime"
+
+	// CronReasonDeadlineExceeded means the cron has passed its deadline.
+	CronReasonDeadlineExceeded = "DeadlineExceeded"
+
+	// CronReasonMaxRunsReached means the cron has scheduled its maximum number of runs.
+	CronReasonMaxRunsReached = "MaxRunsReached"
+
+	// CronReasonWaitingForTermination means the cron is waiting for replaced runs and their pods to be gone.
+	CronReasonWaitingForTermination = "WaitingForTermination"
+
+	// CronReasonWorkloadKindFound means the kind of the workload in the template is served by the API server.
+	CronReasonWorkloadKindFound = "WorkloadKindFound"
+
+	// CronReasonWorkloadKindNotFound means the CRD of the kind of the workload in the template is not installed.
+	CronReasonWorkloadKindNotFound = "WorkloadKindNotFound"
+
+	// CronReasonCalendarFound means the calendars referenced by the cron exist and are valid.
+	CronReasonCalendarFound = "CalendarFound"
+
+	// CronReasonCalendarNotFound means a calendar referenced by the cron does not exist.
+	CronReasonCalendarNotFound = "CalendarNotFound"
+
+	// CronReasonInvalidCalendar means a calendar referenced by the cron is invalid.
+	CronReasonInvalidCalendar = "InvalidCalendar"
+
+	// CronReasonInvalidSchedule means a schedule or the time zone of the cron cannot be parsed.
+	CronReasonInvalidSchedule = "InvalidSchedule"
+)
+
+// CronScheduleStatus represents the observed state of one of multiple schedules of a Cron.
+type CronScheduleStatus struct {
+	// Name is the name of the schedule, or its index in the list if it is not named.
+	// +required
+	Name string `json:"name"`
+
+	// LastScheduleTime records the last time a job was successfully scheduled by this schedule.
+	// +optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+}
+
+// CronQueuedRun represents a scheduled run that is queued until the active run of its schedule has finished.
+type CronQueuedRun struct {
+	// ScheduleTime is the time at which the queued run was scheduled.
+	// +required
+	ScheduleTime metav1.Time `json:"scheduleTime"`
+
+	// Schedule is the name of the schedule of the queued run when multiple schedules are specified.
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+}
+
+// CronSkippedRun represents a scheduled run that was skipped without creating a workload.
+type CronSkippedRun struct {
+	// ScheduleTime is the time at which the skipped run was scheduled.
+	// +required
+	ScheduleTime metav1.Time `json:"scheduleTime"`
+
+	// Schedule is the name of the schedule of the skipped run when multiple schedules are specified.
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+
+	// Reason is a brief CamelCase reason why the run was skipped.
+	// +required
+	Reason string `json:"reason"`
+
+	// Message is a human-readable message indicating details about why the run was skipped.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+const (
+	// SkipReasonStartingDeadlineExceeded means the run was not started within the starting deadline.
+	SkipReasonStartingDeadlineExceeded = "StartingDeadlineExceeded"
+
+	// SkipReasonCatchUpLimitExceeded means the run was missed and exceeds the maximum number of caught up runs.
+	SkipReasonCatchUpLimitExceeded = "CatchUpLimitExceeded"
+
+	// SkipReasonNotCaughtUp means the run was missed and the catch-up policy is None.
+	SkipReasonNotCaughtUp = "NotCaughtUp"
+
+	// SkipReasonBlockedByCalendar means the run was blocked by a referenced CronCalendar.
+	SkipReasonBlockedByCalendar = "BlockedByCalendar"
+
+	// SkipReasonConcurrencyForbidden means the run was not created because of the Forbid concurrency policy.
+	SkipReasonConcurrencyForbidden = "ConcurrencyForbidden"
+
+	// SkipReasonMaxActiveRunsReached means the run was not created because the maximum number of runs were active.
+	SkipReasonMaxActiveRunsReached = "MaxActiveRunsReached"
+
+	// SkipReasonQueueFull means the run was not queued because the maximum number of runs were queued.
+	SkipReasonQueueFull = "QueueFull"
+
+	// SkipReasonConcurrencyGroupFull means the run was not created because the maximum number of runs of
+	// the concurrency group were active.
+	SkipReasonConcurrencyGroupFull = "ConcurrencyGroupFull"
+
+	// SkipReasonSuspended means the run triggered on demand was not created because the Cron is suspended.
+	SkipReasonSuspended = "Suspended"
+
+	// SkipReasonMaxRunsReached means the run triggered on demand was not created because the Cron has scheduled
+	// its maximum number of runs.
+	SkipReasonMaxRunsReached = "MaxRunsReached"
+)
+
+// JobTimedOut is the status in history of a job that was stopped for exceeding the run timeout.
+const JobTimedOut kubeflowv1.JobConditionType = "TimedOut"
+
+// StatusInterpreter describes how the status of a workload was interpreted to classify it as
+// succeeded or failed.
+// +kubebuilder:validation:Enum=StatusRules;BuiltIn;Kubeflow;KStatus
+type StatusInterpreter string
+
+const (
+	// StatusInterpreterStatusRules means the status was interpreted by the status rules of the Cron
+	// or of the kind of the workload in the operator configuration.
+	StatusInterpreterStatusRules StatusInterpreter = "StatusRules"
+
+	// StatusInterpreterBuiltIn means the status was interpreted by the built-in interpretation of the
+	// kind of the workload, e.g. of batch/v1 Jobs, Pods, TrainJobs, JobSets or RayJobs.
+	StatusInterpreterBuiltIn StatusInterpreter = "BuiltIn"
+
+	// StatusInterpreterKubeflow means the status was interpreted as the status of a Kubeflow job.
+	StatusInterpreterKubeflow StatusInterpreter = "Kubeflow"
+
+	// StatusInterpreterKStatus means the status was interpreted following the kstatus conventions, with
+	// the Ready and Stalled conditions and the observed generation of the workload.
+	StatusInterpreterKStatus StatusInterpreter = "KStatus"
+)
+
 // CronHistory represents a historical record of a scheduled cron job execution.
 type CronHistory struct {
 	// UID is the unique identifier of the scheduled job.
@@ -168,7 +664,8 @@ type CronHistory struct {
 	// +required
 	Object corev1.TypedLocalObjectReference `json:"object"`
 
-	// Status is the final status of the job when it finished execution.
+	// Status is the final status of the job when it finished execution,
+	// or TimedOut if the job was stopped for exceeding the run timeout.
 	// +required
 	Status kubeflowv1.JobConditionType `json:"status"`
 
@@ -179,4 +676,20 @@ type CronHistory struct {
 	// Finished is the timestamp when the job finished execution (either succeeded or failed).
 	// +optional
 	Finished *metav1.Time `json:"finished,omitempty"`
+
+	// Manual indicates the job was triggered on demand rather than scheduled.
+	// +optional
+	Manual bool `json:"manual,omitempty"`
+
+	// RetryOf is the name of the original run retried by the job, if the job is a retry.
+	// +optional
+	RetryOf string `json:"retryOf,omitempty"`
+
+	// RetryAttempt is the number of the retry of the original run, starting at 1, if the job is a retry.
+	// +optional
+	RetryAttempt int32 `json:"retryAttempt,omitempty"`
+
+	// StatusInterpreter is how the status of the job was interpreted, unless it timed out.
+	// +optional
+	StatusInterpreter StatusInterpreter `json:"statusInterpreter,omitempty"`
 }
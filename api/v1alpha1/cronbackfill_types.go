@@ -0,0 +1,164 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func init() {
+	SchemeBuilder.Register(&CronBackfill{}, &CronBackfillList{})
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="CRON",type=string,JSONPath=`.spec.cronRef.name`
+// +kubebuilder:printcolumn:name="TOTAL",type=integer,JSONPath=`.status.total`
+// +kubebuilder:printcolumn:name="ACTIVE",type=integer,JSONPath=`.status.active`
+// +kubebuilder:printcolumn:name="SUCCEEDED",type=integer,JSONPath=`.status.succeeded`
+// +kubebuilder:printcolumn:name="FAILED",type=integer,JSONPath=`.status.failed`
+// +kubebuilder:printcolumn:name="AGE",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// CronBackfill is the Schema for the cronbackfills API.
+// It represents a one-off re-run of a Cron for each of its scheduled times within a historical time range.
+type CronBackfill struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// Spec defines the desired state of CronBackfill.
+	// +required
+	Spec CronBackfillSpec `json:"spec"`
+
+	// Status defines the observed state of CronBackfill.
+	// +optional
+	Status CronBackfillStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// CronBackfillList contains a list of CronBackfill resources.
+type CronBackfillList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#lists-and-simple-kinds
+	metav1.ListMeta `json:"metadata,omitzero"`
+
+	// Items is the list of CronBackfill objects.
+	Items []CronBackfill `json:"items"`
+}
+
+// CronBackfillSpec defines the desired state of CronBackfill.
+// +kubebuilder:validation:XValidation:rule="self.to > self.from",message="to must be after from"
+type CronBackfillSpec struct {
+	// CronRef references the Cron in the same namespace whose schedules and template are backfilled.
+	// +required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="cronRef is immutable"
+	CronRef corev1.LocalObjectReference `json:"cronRef"`
+
+	// From is the start of the backfilled time range, inclusive.
+	// +required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="from is immutable"
+	From metav1.Time `json:"from"`
+
+	// To is the end of the backfilled time range, exclusive.
+	// +required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="to is immutable"
+	To metav1.Time `json:"to"`
+
+	// Parallelism is the maximum number of backfilled workloads that are active at the same time.
+	// Backfilled workloads are only bounded by it: they are not subject to the concurrency policy
+	// of the Cron, and do not count towards its concurrency group.
+	// Defaults to 1.
+	// +optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	Parallelism *int32 `json:"parallelism,omitempty"`
+}
+
+// CronBackfillStatus defines the observed state of CronBackfill.
+type CronBackfillStatus struct {
+	// Total is the number of scheduled times of the Cron within the time range.
+	// +optional
+	Total int32 `json:"total,omitempty"`
+
+	// Created is the number of scheduled times that have been backfilled in the order of their scheduled times.
+	// +optional
+	Created int32 `json:"created,omitempty"`
+
+	// Active is the number of backfilled workloads that are running.
+	// +optional
+	Active int32 `json:"active,omitempty"`
+
+	// Succeeded is the number of backfilled workloads that have succeeded.
+	// +optional
+	Succeeded int32 `json:"succeeded,omitempty"`
+
+	// Failed is the number of backfilled workloads that have failed.
+	// +optional
+	Failed int32 `json:"failed,omitempty"`
+
+	// LastScheduleTime is the scheduled time of the last created workload.
+	// +optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+
+	// LastSchedule is the name of the schedule of the last created workload if the Cron has multiple schedules.
+	// +optional
+	LastSchedule string `json:"lastSchedule,omitempty"`
+
+	// CompletionTime is the time when all backfilled workloads have finished.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Conditions represent the latest available observations of the state of the backfill.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+const (
+	// CronBackfillConditionComplete indicates all backfilled workloads have been created and have finished.
+	CronBackfillConditionComplete = "Complete"
+
+	// CronBackfillConditionFailed indicates the backfill cannot proceed, e.g. because its time range
+	// contains too many scheduled times. It is removed once the backfill can proceed again.
+	CronBackfillConditionFailed = "Failed"
+)
+
+const (
+	// CronBackfillReasonCompleted means all backfilled workloads have finished.
+	CronBackfillReasonCompleted = "Completed"
+
+	// CronBackfillReasonInvalidSchedule means the schedules of the Cron cannot be parsed.
+	CronBackfillReasonInvalidSchedule = "InvalidSchedule"
+
+	// CronBackfillReasonInvalidTemplate means no workload can be created from the template of the Cron.
+	CronBackfillReasonInvalidTemplate = "InvalidTemplate"
+
+	// CronBackfillReasonNameConflict means a workload that is not controlled by the backfill has the name of
+	// a backfilled workload. The backfill proceeds once it has been deleted.
+	CronBackfillReasonNameConflict = "NameConflict"
+
+	// CronBackfillReasonTooManyScheduleTimes means the time range contains too many scheduled times.
+	CronBackfillReasonTooManyScheduleTimes = "TooManyScheduleTimes"
+)
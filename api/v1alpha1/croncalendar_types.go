@@ -0,0 +1,118 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func init() {
+	SchemeBuilder.Register(&CronCalendar{}, &CronCalendarList{})
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="TIME_ZONE",type=string,JSONPath=`.spec.timeZone`
+// +kubebuilder:printcolumn:name="AGE",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// CronCalendar is the Schema for the croncalendars API.
+// It represents a calendar of dates and time windows in which the Crons referencing it do not run,
+// e.g. company holidays and cluster maintenance windows.
+type CronCalendar struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard object's metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// Spec defines the excluded dates and time windows of CronCalendar.
+	// +required
+	Spec CronCalendarSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// CronCalendarList contains a list of CronCalendar resources.
+type CronCalendarList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#lists-and-simple-kinds
+	metav1.ListMeta `json:"metadata,omitzero"`
+
+	// Items is the list of CronCalendar objects.
+	Items []CronCalendar `json:"items"`
+}
+
+// CronCalendarSpec defines the excluded dates and time windows of CronCalendar.
+type CronCalendarSpec struct {
+	// TimeZone is the name of the time zone in which the dates and times of the calendar are interpreted,
+	// e.g. "Asia/Shanghai". It must be a valid name from the IANA time zone database.
+	// If not specified, the local time zone of the controller is used.
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
+
+	// ExcludedDates is a list of whole days on which runs are blocked.
+	// +optional
+	// +listType=atomic
+	ExcludedDates []CronCalendarDate `json:"excludedDates,omitempty"`
+
+	// ExcludedWindows is a list of time windows in which runs are blocked.
+	// +optional
+	// +listType=atomic
+	ExcludedWindows []CronCalendarWindow `json:"excludedWindows,omitempty"`
+}
+
+// CronCalendarDate is a day on which runs are blocked.
+type CronCalendarDate struct {
+	// Date is the blocked day in "YYYY-MM-DD" format for a one-time date, e.g. "2026-10-01",
+	// or in "MM-DD" format for a date recurring every year, e.g. "12-25".
+	// +required
+	// +kubebuilder:validation:Pattern=`^([0-9]{4}-)?[0-9]{2}-[0-9]{2}$`
+	Date string `json:"date"`
+
+	// Reason is a human-readable reason why runs are blocked, e.g. the name of a holiday.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// CronCalendarWindow is a time window in which runs are blocked.
+// A one-time window is specified by Start and End, and a recurring window by Schedule and Duration.
+// +kubebuilder:validation:XValidation:rule="has(self.start) == has(self.end) && has(self.schedule) == has(self.duration) && has(self.start) != has(self.schedule)",message="exactly one of start and end, or schedule and duration must be specified"
+type CronCalendarWindow struct {
+	// Start is the start time of a one-time window, inclusive.
+	// +optional
+	Start *metav1.Time `json:"start,omitempty"`
+
+	// End is the end time of a one-time window, exclusive.
+	// +optional
+	End *metav1.Time `json:"end,omitempty"`
+
+	// Schedule specifies the start times of a recurring window in standard cron format,
+	// e.g. "0 22 * * 6" for a window starting at 22:00 every Saturday. Intervals such as "@every 24h"
+	// are not supported.
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+
+	// Duration is the duration of each recurring window, e.g. "4h".
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// Reason is a human-readable reason why runs are blocked, e.g. "cluster maintenance".
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
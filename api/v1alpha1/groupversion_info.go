@@ -26,6 +26,8 @@ import (
 
 const (
 	KindCron = "Cron"
+
+	KindCronCalendar = "CronCalendar"
 )
 
 var (
@@ -22,6 +22,7 @@ package v1alpha1
 
 import (
 	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -52,6 +53,251 @@ func (in *Cron) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronBackfill) DeepCopyInto(out *CronBackfill) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronBackfill.
+func (in *CronBackfill) DeepCopy() *CronBackfill {
+	if in == nil {
+		return nil
+	}
+	out := new(CronBackfill)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CronBackfill) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronBackfillList) DeepCopyInto(out *CronBackfillList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CronBackfill, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronBackfillList.
+func (in *CronBackfillList) DeepCopy() *CronBackfillList {
+	if in == nil {
+		return nil
+	}
+	out := new(CronBackfillList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CronBackfillList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronBackfillSpec) DeepCopyInto(out *CronBackfillSpec) {
+	*out = *in
+	out.CronRef = in.CronRef
+	in.From.DeepCopyInto(&out.From)
+	in.To.DeepCopyInto(&out.To)
+	if in.Parallelism != nil {
+		in, out := &in.Parallelism, &out.Parallelism
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronBackfillSpec.
+func (in *CronBackfillSpec) DeepCopy() *CronBackfillSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CronBackfillSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronBackfillStatus) DeepCopyInto(out *CronBackfillStatus) {
+	*out = *in
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronBackfillStatus.
+func (in *CronBackfillStatus) DeepCopy() *CronBackfillStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CronBackfillStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronCalendar) DeepCopyInto(out *CronCalendar) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronCalendar.
+func (in *CronCalendar) DeepCopy() *CronCalendar {
+	if in == nil {
+		return nil
+	}
+	out := new(CronCalendar)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CronCalendar) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronCalendarDate) DeepCopyInto(out *CronCalendarDate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronCalendarDate.
+func (in *CronCalendarDate) DeepCopy() *CronCalendarDate {
+	if in == nil {
+		return nil
+	}
+	out := new(CronCalendarDate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronCalendarList) DeepCopyInto(out *CronCalendarList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CronCalendar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronCalendarList.
+func (in *CronCalendarList) DeepCopy() *CronCalendarList {
+	if in == nil {
+		return nil
+	}
+	out := new(CronCalendarList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CronCalendarList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronCalendarSpec) DeepCopyInto(out *CronCalendarSpec) {
+	*out = *in
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExcludedDates != nil {
+		in, out := &in.ExcludedDates, &out.ExcludedDates
+		*out = make([]CronCalendarDate, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedWindows != nil {
+		in, out := &in.ExcludedWindows, &out.ExcludedWindows
+		*out = make([]CronCalendarWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronCalendarSpec.
+func (in *CronCalendarSpec) DeepCopy() *CronCalendarSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CronCalendarSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronCalendarWindow) DeepCopyInto(out *CronCalendarWindow) {
+	*out = *in
+	if in.Start != nil {
+		in, out := &in.Start, &out.Start
+		*out = (*in).DeepCopy()
+	}
+	if in.End != nil {
+		in, out := &in.End, &out.End
+		*out = (*in).DeepCopy()
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronCalendarWindow.
+func (in *CronCalendarWindow) DeepCopy() *CronCalendarWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(CronCalendarWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CronHistory) DeepCopyInto(out *CronHistory) {
 	*out = *in
@@ -108,19 +354,204 @@ func (in *CronList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronQueuedRun) DeepCopyInto(out *CronQueuedRun) {
+	*out = *in
+	in.ScheduleTime.DeepCopyInto(&out.ScheduleTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronQueuedRun.
+func (in *CronQueuedRun) DeepCopy() *CronQueuedRun {
+	if in == nil {
+		return nil
+	}
+	out := new(CronQueuedRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronReplacePolicy) DeepCopyInto(out *CronReplacePolicy) {
+	*out = *in
+	if in.GracePeriodSeconds != nil {
+		in, out := &in.GracePeriodSeconds, &out.GracePeriodSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronReplacePolicy.
+func (in *CronReplacePolicy) DeepCopy() *CronReplacePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CronReplacePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronRetryPolicy) DeepCopyInto(out *CronRetryPolicy) {
+	*out = *in
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxBackoff != nil {
+		in, out := &in.MaxBackoff, &out.MaxBackoff
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronRetryPolicy.
+func (in *CronRetryPolicy) DeepCopy() *CronRetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CronRetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronSchedule) DeepCopyInto(out *CronSchedule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronSchedule.
+func (in *CronSchedule) DeepCopy() *CronSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(CronSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronScheduleStatus) DeepCopyInto(out *CronScheduleStatus) {
+	*out = *in
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronScheduleStatus.
+func (in *CronScheduleStatus) DeepCopy() *CronScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CronScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronSkippedRun) DeepCopyInto(out *CronSkippedRun) {
+	*out = *in
+	in.ScheduleTime.DeepCopyInto(&out.ScheduleTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronSkippedRun.
+func (in *CronSkippedRun) DeepCopy() *CronSkippedRun {
+	if in == nil {
+		return nil
+	}
+	out := new(CronSkippedRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CronSpec) DeepCopyInto(out *CronSpec) {
 	*out = *in
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]CronSchedule, len(*in))
+		copy(*out, *in)
+	}
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.Jitter != nil {
+		in, out := &in.Jitter, &out.Jitter
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.CalendarRefs != nil {
+		in, out := &in.CalendarRefs, &out.CalendarRefs
+		*out = make([]v1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
 	in.Template.DeepCopyInto(&out.Template)
+	if in.MaxQueuedRuns != nil {
+		in, out := &in.MaxQueuedRuns, &out.MaxQueuedRuns
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ConcurrencyGroupLimit != nil {
+		in, out := &in.ConcurrencyGroupLimit, &out.ConcurrencyGroupLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ReplacePolicy != nil {
+		in, out := &in.ReplacePolicy, &out.ReplacePolicy
+		*out = new(CronReplacePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxActiveRuns != nil {
+		in, out := &in.MaxActiveRuns, &out.MaxActiveRuns
+		*out = new(int32)
+		**out = **in
+	}
 	if in.Suspend != nil {
 		in, out := &in.Suspend, &out.Suspend
 		*out = new(bool)
 		**out = **in
 	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
 	if in.Deadline != nil {
 		in, out := &in.Deadline, &out.Deadline
 		*out = (*in).DeepCopy()
 	}
+	if in.StartingDeadlineSeconds != nil {
+		in, out := &in.StartingDeadlineSeconds, &out.StartingDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxCatchUpRuns != nil {
+		in, out := &in.MaxCatchUpRuns, &out.MaxCatchUpRuns
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxRuns != nil {
+		in, out := &in.MaxRuns, &out.MaxRuns
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RunTimeout != nil {
+		in, out := &in.RunTimeout, &out.RunTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(CronRetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.HistoryLimit != nil {
 		in, out := &in.HistoryLimit, &out.HistoryLimit
 		*out = new(int)
@@ -146,6 +577,16 @@ func (in *CronStatus) DeepCopyInto(out *CronStatus) {
 		*out = make([]v1.ObjectReference, len(*in))
 		copy(*out, *in)
 	}
+	if in.Replacing != nil {
+		in, out := &in.Replacing, &out.Replacing
+		*out = make([]v1.ObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.ConcurrencyGroupHolders != nil {
+		in, out := &in.ConcurrencyGroupHolders, &out.ConcurrencyGroupHolders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.History != nil {
 		in, out := &in.History, &out.History
 		*out = make([]CronHistory, len(*in))
@@ -157,6 +598,34 @@ func (in *CronStatus) DeepCopyInto(out *CronStatus) {
 		in, out := &in.LastScheduleTime, &out.LastScheduleTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]CronScheduleStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.QueuedRuns != nil {
+		in, out := &in.QueuedRuns, &out.QueuedRuns
+		*out = make([]CronQueuedRun, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SkippedRuns != nil {
+		in, out := &in.SkippedRuns, &out.SkippedRuns
+		*out = make([]CronSkippedRun, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronStatus.
@@ -178,6 +647,11 @@ func (in *CronTemplateSpec) DeepCopyInto(out *CronTemplateSpec) {
 		*out = new(runtime.RawExtension)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.StatusRules != nil {
+		in, out := &in.StatusRules, &out.StatusRules
+		*out = new(WorkloadStatusRules)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronTemplateSpec.
@@ -189,3 +663,18 @@ func (in *CronTemplateSpec) DeepCopy() *CronTemplateSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadStatusRules) DeepCopyInto(out *WorkloadStatusRules) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadStatusRules.
+func (in *WorkloadStatusRules) DeepCopy() *WorkloadStatusRules {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadStatusRules)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -71,3 +71,23 @@ app.kubernetes.io/instance: {{ .Release.Name }}
 {{- define "cron-operator.service.name" -}}
 {{- include "cron-operator.fullname" . }}
 {{- end }}
+
+{{- /* Name of the workload status rules config map. */ -}}
+{{- define "cron-operator.statusRules.name" -}}
+{{- include "cron-operator.fullname" . }}-status-rules
+{{- end }}
+
+{{- /* Name of the webhook. */ -}}
+{{- define "cron-operator.webhook.name" -}}
+{{- include "cron-operator.fullname" . }}-webhook
+{{- end }}
+
+{{- /* Name of the webhook service. */ -}}
+{{- define "cron-operator.webhook.service.name" -}}
+{{- include "cron-operator.webhook.name" . }}
+{{- end }}
+
+{{- /* Name of the webhook certificate secret. */ -}}
+{{- define "cron-operator.webhook.secret.name" -}}
+{{- include "cron-operator.webhook.name" . }}-certs
+{{- end }}
@@ -33,6 +33,7 @@ import (
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/config"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
@@ -42,6 +43,9 @@ import (
 
 	"github.com/AliyunContainerService/cron-operator/api/v1alpha1"
 	"github.com/AliyunContainerService/cron-operator/internal/controller"
+	"github.com/AliyunContainerService/cron-operator/internal/ratelimit"
+	"github.com/AliyunContainerService/cron-operator/internal/statusrule"
+	webhookv1alpha1 "github.com/AliyunContainerService/cron-operator/internal/webhook/v1alpha1"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -63,6 +67,10 @@ func NewStartCommand() *cobra.Command {
 		maxConcurrentReconciles                          int
 		qps                                              float32
 		burst                                            int
+		creationQPS                                      float64
+		creationBurst                                    int
+		creationNamespaceShare                           float64
+		statusRulesFile                                  string
 		metricsAddr                                      string
 		metricsCertPath, metricsCertName, metricsCertKey string
 		webhookCertPath, webhookCertName, webhookCertKey string
@@ -70,6 +78,7 @@ func NewStartCommand() *cobra.Command {
 		probeAddr                                        string
 		secureMetrics                                    bool
 		enableHTTP2                                      bool
+		enableWebhook                                    bool
 	)
 
 	opts := logzap.Options{}
@@ -174,22 +183,66 @@ func NewStartCommand() *cobra.Command {
 				Controller: config.Controller{
 					MaxConcurrentReconciles: maxConcurrentReconciles,
 				},
+				// Only the Jobs and Pods created by Crons are cached.
+				Cache: cache.Options{
+					ByObject: controller.WorkloadCacheByObject(),
+				},
 			})
 			if err != nil {
 				log.Error(err, "unable to start manager")
 				os.Exit(1)
 			}
 
+			// The workload creations of all controllers share the same rate limit.
+			limiter := ratelimit.New(creationQPS, creationBurst, creationNamespaceShare)
+
+			// The status rules of workload kinds are shared by all controllers as well. The set is built
+			// even without a configuration file, so that the status rules of Crons are compiled only once.
+			statusRules, err := statusrule.NewSet(statusrule.Config{})
+			if len(statusRulesFile) > 0 {
+				statusRules, err = statusrule.Load(statusRulesFile)
+			}
+			if err != nil {
+				log.Error(err, "unable to load workload status rules", "workload-status-rules-file", statusRulesFile)
+				os.Exit(1)
+			}
+
 			cronReconciler := controller.NewCronReconciler(
 				mgr.GetScheme(),
 				mgr.GetClient(),
 				mgr.GetAPIReader(),
 				mgr.GetEventRecorderFor("cron"),
+				limiter,
+				statusRules,
 			)
 			if err := cronReconciler.SetupWithManager(mgr); err != nil {
 				log.Error(err, "unable to create controller", "controller", "Cron")
 				os.Exit(1)
 			}
+
+			cronBackfillReconciler := controller.NewCronBackfillReconciler(
+				mgr.GetScheme(),
+				mgr.GetClient(),
+				mgr.GetAPIReader(),
+				mgr.GetEventRecorderFor("cronbackfill"),
+				limiter,
+				statusRules,
+			)
+			if err := cronBackfillReconciler.SetupWithManager(mgr); err != nil {
+				log.Error(err, "unable to create controller", "controller", "CronBackfill")
+				os.Exit(1)
+			}
+
+			if enableWebhook {
+				if err := webhookv1alpha1.SetupCronWebhookWithManager(mgr); err != nil {
+					log.Error(err, "unable to create webhook", "webhook", "Cron")
+					os.Exit(1)
+				}
+				if err := webhookv1alpha1.SetupCronCalendarWebhookWithManager(mgr); err != nil {
+					log.Error(err, "unable to create webhook", "webhook", "CronCalendar")
+					os.Exit(1)
+				}
+			}
 			// +kubebuilder:scaffold:builder
 
 			if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -217,6 +270,13 @@ func NewStartCommand() *cobra.Command {
 	)
 	cmd.Flags().Float32Var(&qps, "qps", 30, "Maximum QPS to the Kubernetes API server from this client.")
 	cmd.Flags().IntVar(&burst, "burst", 50, "Maximum burst for throttle.")
+	cmd.Flags().Float64Var(&creationQPS, "workload-creation-qps", 10,
+		"Maximum number of workloads created per second across the operator. Set to 0 to disable the limit.")
+	cmd.Flags().IntVar(&creationBurst, "workload-creation-burst", 20, "Maximum burst of workload creations across the operator.")
+	cmd.Flags().Float64Var(&creationNamespaceShare, "workload-creation-namespace-share", 0.5,
+		"Fraction of the workload creation rate and burst available to a single namespace, between 0 and 1.")
+	cmd.Flags().StringVar(&statusRulesFile, "workload-status-rules-file", "",
+		"The file with the CEL status rules of workload kinds, which interpret their status instead of the built-in ones.")
 	cmd.Flags().StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
 	cmd.Flags().StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
@@ -240,6 +300,8 @@ func NewStartCommand() *cobra.Command {
 	cmd.Flags().StringVar(&metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
 	cmd.Flags().BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	cmd.Flags().BoolVar(&enableWebhook, "enable-webhook", false,
+		"If set, the validating webhooks for Cron and CronCalendar will be served by the webhook server.")
 
 	// Bind zap flags to a flag.FlagSet then add to cobra.
 	zapFlags := flag.NewFlagSet("zap", flag.ExitOnError)
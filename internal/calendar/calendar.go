@@ -0,0 +1,194 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package calendar evaluates CronCalendars to tell whether runs at a given time are blocked.
+package calendar
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	cronv3 "github.com/robfig/cron/v3"
+
+	"github.com/AliyunContainerService/cron-operator/api/v1alpha1"
+	"github.com/AliyunContainerService/cron-operator/internal/schedule"
+)
+
+const (
+	// dateLayout is the layout of one-time excluded dates.
+	dateLayout = "2006-01-02"
+
+	// yearlyDateLayout is the layout of excluded dates recurring every year.
+	yearlyDateLayout = "01-02"
+)
+
+// Date is an excluded day of a calendar.
+type Date struct {
+	// Year is the year of a one-time date, and zero for a date recurring every year.
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// ParseDate parses an excluded date in "YYYY-MM-DD" or "MM-DD" format.
+func ParseDate(s string) (Date, error) {
+	if strings.Count(s, "-") == 1 {
+		// Parse in a leap year so that February 29th is accepted.
+		t, err := time.Parse(dateLayout, "2000-"+s)
+		if err != nil {
+			return Date{}, fmt.Errorf("invalid date %q, expected MM-DD format", s)
+		}
+		return Date{Month: t.Month(), Day: t.Day()}, nil
+	}
+
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return Date{}, fmt.Errorf("invalid date %q, expected YYYY-MM-DD format", s)
+	}
+	return Date{Year: t.Year(), Month: t.Month(), Day: t.Day()}, nil
+}
+
+// matches reports whether the given time is on the date.
+func (d Date) matches(t time.Time) bool {
+	return (d.Year == 0 || d.Year == t.Year()) && d.Month == t.Month() && d.Day == t.Day()
+}
+
+// excludedDate is a parsed excluded date.
+type excludedDate struct {
+	date   Date
+	reason string
+}
+
+// excludedWindow is a parsed excluded time window, either one-time or recurring.
+type excludedWindow struct {
+	start    time.Time
+	end      time.Time
+	schedule cronv3.Schedule
+	duration time.Duration
+	reason   string
+}
+
+// contains reports whether the given time is within the window.
+func (w excludedWindow) contains(t time.Time) bool {
+	if w.schedule == nil {
+		return !t.Before(w.start) && t.Before(w.end)
+	}
+
+	// The time is within a recurring window if the window started at most its duration ago.
+	start := w.schedule.Next(t.Add(-w.duration))
+	return !start.IsZero() && !start.After(t)
+}
+
+// ParseWindowSchedule parses the schedule of a recurring window whose times are evaluated in the given time zone.
+// Intervals such as "@every 24h" are rejected, as they do not start windows at fixed times.
+func ParseWindowSchedule(spec string, timeZone *string) (cronv3.Schedule, error) {
+	sched, err := schedule.Parse(spec, v1alpha1.ScheduleFormatStandard, timeZone)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := sched.(cronv3.ConstantDelaySchedule); ok {
+		return nil, fmt.Errorf("intervals are not supported in window schedules")
+	}
+	return sched, nil
+}
+
+// Calendar is a parsed CronCalendar.
+type Calendar struct {
+	name    string
+	loc     *time.Location
+	dates   []excludedDate
+	windows []excludedWindow
+}
+
+// New parses the given CronCalendar.
+func New(cal *v1alpha1.CronCalendar) (*Calendar, error) {
+	loc, err := schedule.LoadLocation(cal.Spec.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Calendar{
+		name: cal.Name,
+		loc:  loc,
+	}
+
+	for _, d := range cal.Spec.ExcludedDates {
+		date, err := ParseDate(d.Date)
+		if err != nil {
+			return nil, err
+		}
+		c.dates = append(c.dates, excludedDate{date: date, reason: d.Reason})
+	}
+
+	for _, w := range cal.Spec.ExcludedWindows {
+		window := excludedWindow{reason: w.Reason}
+		switch {
+		case w.Start != nil && w.End != nil:
+			window.start = w.Start.Time
+			window.end = w.End.Time
+		case w.Schedule != "" && w.Duration != nil:
+			window.schedule, err = ParseWindowSchedule(w.Schedule, cal.Spec.TimeZone)
+			if err != nil {
+				return nil, fmt.Errorf("unparsable window schedule %q: %w", w.Schedule, err)
+			}
+			window.duration = w.Duration.Duration
+		default:
+			return nil, fmt.Errorf("window must specify either start and end, or schedule and duration")
+		}
+		c.windows = append(c.windows, window)
+	}
+
+	return c, nil
+}
+
+// Name returns the name of the CronCalendar.
+func (c *Calendar) Name() string {
+	return c.name
+}
+
+// Blocks reports whether runs at the given time are blocked by the calendar,
+// and returns the reason of the excluded date or window if so.
+func (c *Calendar) Blocks(t time.Time) (string, bool) {
+	local := t.In(c.loc)
+	for _, d := range c.dates {
+		if d.date.matches(local) {
+			return d.reason, true
+		}
+	}
+
+	for _, w := range c.windows {
+		if w.contains(local) {
+			return w.reason, true
+		}
+	}
+
+	return "", false
+}
+
+// Calendars is a list of calendars, which block the runs blocked by any of them.
+type Calendars []*Calendar
+
+// Blocks reports whether runs at the given time are blocked by any of the calendars,
+// and returns the blocking calendar if so.
+func (cs Calendars) Blocks(t time.Time) (*Calendar, string, bool) {
+	for _, c := range cs {
+		if reason, blocked := c.Blocks(t); blocked {
+			return c, reason, true
+		}
+	}
+	return nil, "", false
+}
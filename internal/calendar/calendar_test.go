@@ -0,0 +1,169 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package calendar
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/AliyunContainerService/cron-operator/api/v1alpha1"
+)
+
+var _ = Describe("Calendar", func() {
+	Context("ParseDate", func() {
+		It("should parse one-time and yearly dates", func() {
+			d, err := ParseDate("2026-10-01")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(d).To(Equal(Date{Year: 2026, Month: time.October, Day: 1}))
+
+			d, err = ParseDate("02-29")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(d).To(Equal(Date{Month: time.February, Day: 29}))
+		})
+
+		It("should return error for invalid dates", func() {
+			for _, s := range []string{"2026-02-30", "13-01", "2026/10/01", "tomorrow"} {
+				_, err := ParseDate(s)
+				Expect(err).To(HaveOccurred(), s)
+			}
+		})
+	})
+
+	Context("Blocks", func() {
+		var cal *Calendar
+
+		BeforeEach(func() {
+			var err error
+			cal, err = New(&v1alpha1.CronCalendar{
+				ObjectMeta: metav1.ObjectMeta{Name: "holidays"},
+				Spec: v1alpha1.CronCalendarSpec{
+					TimeZone: ptr.To("Asia/Shanghai"),
+					ExcludedDates: []v1alpha1.CronCalendarDate{
+						{Date: "2026-10-01", Reason: "National Day"},
+						{Date: "12-25", Reason: "Christmas"},
+					},
+					ExcludedWindows: []v1alpha1.CronCalendarWindow{
+						{
+							Start:  ptr.To(metav1.NewTime(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))),
+							End:    ptr.To(metav1.NewTime(time.Date(2026, 3, 1, 6, 0, 0, 0, time.UTC))),
+							Reason: "upgrade",
+						},
+						{
+							Schedule: "0 22 * * 6",
+							Duration: &metav1.Duration{Duration: 4 * time.Hour},
+							Reason:   "maintenance",
+						},
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cal.Name()).To(Equal("holidays"))
+		})
+
+		It("should block excluded dates in the calendar time zone", func() {
+			reason, blocked := cal.Blocks(time.Date(2026, 9, 30, 16, 0, 0, 0, time.UTC))
+			Expect(blocked).To(BeTrue())
+			Expect(reason).To(Equal("National Day"))
+
+			_, blocked = cal.Blocks(time.Date(2026, 9, 30, 15, 59, 0, 0, time.UTC))
+			Expect(blocked).To(BeFalse())
+		})
+
+		It("should block yearly dates every year", func() {
+			reason, blocked := cal.Blocks(time.Date(2030, 12, 25, 12, 0, 0, 0, time.UTC))
+			Expect(blocked).To(BeTrue())
+			Expect(reason).To(Equal("Christmas"))
+		})
+
+		It("should block one-time windows with exclusive end", func() {
+			reason, blocked := cal.Blocks(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+			Expect(blocked).To(BeTrue())
+			Expect(reason).To(Equal("upgrade"))
+
+			_, blocked = cal.Blocks(time.Date(2026, 3, 1, 6, 0, 0, 0, time.UTC))
+			Expect(blocked).To(BeFalse())
+		})
+
+		It("should block recurring windows", func() {
+			// 2026-01-03 is a Saturday, the window lasts from 22:00 to 02:00 in Asia/Shanghai.
+			for _, t := range []time.Time{
+				time.Date(2026, 1, 3, 14, 0, 0, 0, time.UTC),
+				time.Date(2026, 1, 3, 17, 59, 59, 0, time.UTC),
+			} {
+				reason, blocked := cal.Blocks(t)
+				Expect(blocked).To(BeTrue(), t.String())
+				Expect(reason).To(Equal("maintenance"))
+			}
+
+			for _, t := range []time.Time{
+				time.Date(2026, 1, 3, 13, 59, 59, 0, time.UTC),
+				time.Date(2026, 1, 3, 18, 0, 0, 0, time.UTC),
+				time.Date(2026, 1, 4, 14, 0, 0, 0, time.UTC),
+			} {
+				_, blocked := cal.Blocks(t)
+				Expect(blocked).To(BeFalse(), t.String())
+			}
+		})
+
+		It("should return the blocking calendar of calendars", func() {
+			other, err := New(&v1alpha1.CronCalendar{ObjectMeta: metav1.ObjectMeta{Name: "empty"}})
+			Expect(err).NotTo(HaveOccurred())
+
+			blocking, reason, blocked := Calendars{other, cal}.Blocks(time.Date(2030, 12, 25, 12, 0, 0, 0, time.UTC))
+			Expect(blocked).To(BeTrue())
+			Expect(blocking).To(Equal(cal))
+			Expect(reason).To(Equal("Christmas"))
+
+			_, _, blocked = Calendars{other}.Blocks(time.Date(2030, 12, 25, 12, 0, 0, 0, time.UTC))
+			Expect(blocked).To(BeFalse())
+		})
+	})
+
+	Context("New", func() {
+		It("should return error for invalid calendars", func() {
+			_, err := New(&v1alpha1.CronCalendar{Spec: v1alpha1.CronCalendarSpec{TimeZone: ptr.To("Mars/Olympus_Mons")}})
+			Expect(err).To(HaveOccurred())
+
+			_, err = New(&v1alpha1.CronCalendar{Spec: v1alpha1.CronCalendarSpec{
+				ExcludedWindows: []v1alpha1.CronCalendarWindow{{Schedule: "60 * * * *", Duration: &metav1.Duration{Duration: time.Hour}}},
+			}})
+			Expect(err).To(HaveOccurred())
+
+			_, err = New(&v1alpha1.CronCalendar{Spec: v1alpha1.CronCalendarSpec{
+				ExcludedWindows: []v1alpha1.CronCalendarWindow{{Reason: "nothing"}},
+			}})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should return error for windows recurring at intervals", func() {
+			_, err := New(&v1alpha1.CronCalendar{Spec: v1alpha1.CronCalendarSpec{
+				ExcludedWindows: []v1alpha1.CronCalendarWindow{{Schedule: "@every 24h", Duration: &metav1.Duration{Duration: 4 * time.Hour}}},
+			}})
+			Expect(err).To(MatchError(ContainSubstring("intervals are not supported")))
+
+			// Descriptors start windows at fixed times.
+			_, err = New(&v1alpha1.CronCalendar{Spec: v1alpha1.CronCalendarSpec{
+				ExcludedWindows: []v1alpha1.CronCalendarWindow{{Schedule: "@daily", Duration: &metav1.Duration{Duration: 4 * time.Hour}}},
+			}})
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
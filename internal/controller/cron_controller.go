@@ -21,68 +21,168 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
-	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
 	kubeflowutil "github.com/kubeflow/training-operator/pkg/util"
 	cronv3 "github.com/robfig/cron/v3"
 	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/AliyunContainerService/cron-operator/api/v1alpha1"
+	"github.com/AliyunContainerService/cron-operator/internal/calendar"
+	"github.com/AliyunContainerService/cron-operator/internal/ratelimit"
+	"github.com/AliyunContainerService/cron-operator/internal/schedule"
+	"github.com/AliyunContainerService/cron-operator/internal/statusrule"
 	"github.com/AliyunContainerService/cron-operator/pkg/common"
 )
 
+const (
+	// maxSkippedRuns is the maximum number of skipped runs recorded in Cron status.
+	maxSkippedRuns = 10
+
+	// defaultMaxCatchUpRuns is the default maximum number of missed runs created with the All catch-up policy.
+	defaultMaxCatchUpRuns = 10
+
+	// maxBlockedLookahead is the maximum number of scheduled times looked ahead for the next run not blocked by calendars.
+	maxBlockedLookahead = 10000
+
+	// defaultMaxQueuedRuns is the default maximum number of queued runs of each schedule with the Queue concurrency policy.
+	defaultMaxQueuedRuns = 10
+
+	// defaultReplaceTimeout is the default maximum duration to wait for replaced runs to terminate.
+	defaultReplaceTimeout = 10 * time.Minute
+
+	// replacePollInterval is how often replaced runs are checked while waiting for them to terminate.
+	replacePollInterval = 5 * time.Second
+
+	// maxOwnerDepth is the maximum number of controllers followed from a pod to the workload it belongs to,
+	// e.g. from a pod to its Job, JobSet and TrainJob.
+	maxOwnerDepth = 4
+
+	// defaultRetryBackoff is the default delay after a failure before the first retry of a failed run.
+	defaultRetryBackoff = 10 * time.Second
+
+	// defaultMaxRetryBackoff is the default maximum delay after a failure before a retry of a failed run.
+	defaultMaxRetryBackoff = time.Hour
+
+	// catchUpTolerance is how late a run may be observed after its scheduled time
+	// before it is considered missed with the None catch-up policy.
+	catchUpTolerance = time.Minute
+
+	// minWorkloadKindBackoff is the minimum delay before looking up a workload kind whose CRD is not installed again.
+	minWorkloadKindBackoff = 10 * time.Second
+
+	// maxWorkloadKindBackoff is the maximum delay before looking up a workload kind whose CRD is not installed again.
+	maxWorkloadKindBackoff = 5 * time.Minute
+
+	// minCalendarBackoff is the minimum delay before looking up a missing or invalid calendar again.
+	minCalendarBackoff = 10 * time.Second
+
+	// maxCalendarBackoff is the maximum delay before looking up a missing or invalid calendar again.
+	maxCalendarBackoff = 5 * time.Minute
+)
+
 // CronReconciler reconciles a Cron object.
 type CronReconciler struct {
 	scheme   *runtime.Scheme
 	client   client.Client
 	reader   client.Reader
 	recorder record.EventRecorder
+	limiter  *ratelimit.Limiter
+	// statusRules are the status rules of workload kinds in the operator configuration.
+	statusRules *statusrule.Set
+	// watcher watches the workloads of kinds seen in templates that are not watched at startup.
+	watcher *workloadWatcher
+	// groupLocks serialize the reconciliations of the Crons of each concurrency group.
+	groupLocks keyedMutex
+	// throttled records the schedules whose missed runs are held back by the rate limit, so that
+	// they are not skipped for being late while waiting for it.
+	throttled throttledSchedules
 }
 
 // CronReconciler implements reconcile.Reconciler.
 var _ reconcile.Reconciler = &CronReconciler{}
 
 // NewCronReconciler creates a new CronReconciler instance.
-func NewCronReconciler(s *runtime.Scheme, c client.Client, r client.Reader, recorder record.EventRecorder) *CronReconciler {
+// The limiter bounds the rate of workload creations, and may be nil to create workloads without limit.
+// The status rules interpret the status of workloads of the configured kinds, and may be nil if there are none.
+func NewCronReconciler(s *runtime.Scheme, c client.Client, r client.Reader, recorder record.EventRecorder, limiter *ratelimit.Limiter, statusRules *statusrule.Set) *CronReconciler {
 	return &CronReconciler{
-		scheme:   s,
-		client:   c,
-		reader:   r,
-		recorder: recorder,
+		scheme:      s,
+		client:      c,
+		reader:      r,
+		recorder:    recorder,
+		limiter:     limiter,
+		statusRules: statusRules,
 	}
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *CronReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&v1alpha1.Cron{}).
-		Owns(&kubeflowv1.PyTorchJob{}).
-		Owns(&kubeflowv1.TFJob{}).
+	b, watched, err := ownWorkloads(mgr, ctrl.NewControllerManagedBy(mgr).For(&v1alpha1.Cron{}))
+	if err != nil {
+		return err
+	}
+	c, err := b.
+		Watches(&v1alpha1.CronCalendar{}, handler.EnqueueRequestsFromMapFunc(r.mapCalendarToCrons)).
+		Watches(&v1alpha1.Cron{}, handler.EnqueueRequestsFromMapFunc(r.mapCronToConcurrencyGroup)).
 		WithLogConstructor(logConstructor(mgr.GetLogger(), "cron")).
-		Complete(r)
+		Build(r)
+	if err != nil {
+		return err
+	}
+	r.watcher = newWorkloadWatcher(mgr, c, &v1alpha1.Cron{}, watched)
+	return nil
 }
 
 // +kubebuilder:rbac:groups=kubedl.io,resources=crons,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=kubedl.io,resources=crons/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=kubedl.io,resources=crons/finalizers,verbs=update
+// +kubebuilder:rbac:groups=apps.kubedl.io,resources=croncalendars,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=pods/status,verbs=get
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=batch,resources=jobs/status,verbs=get
+// +kubebuilder:rbac:groups=jobset.x-k8s.io,resources=jobsets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=jobset.x-k8s.io,resources=jobsets/status,verbs=get
+// +kubebuilder:rbac:groups=kubeflow.org,resources=jaxjobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=kubeflow.org,resources=jaxjobs/status,verbs=get
+// +kubebuilder:rbac:groups=kubeflow.org,resources=mpijobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=kubeflow.org,resources=mpijobs/status,verbs=get
+// +kubebuilder:rbac:groups=kubeflow.org,resources=paddlejobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=kubeflow.org,resources=paddlejobs/status,verbs=get
 // +kubebuilder:rbac:groups=kubeflow.org,resources=pytorchjobs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=kubeflow.org,resources=pytorchjobs/status,verbs=get
 // +kubebuilder:rbac:groups=kubeflow.org,resources=tfjobs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=kubeflow.org,resources=tfjobs/status,verbs=get
+// +kubebuilder:rbac:groups=kubeflow.org,resources=xgboostjobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=kubeflow.org,resources=xgboostjobs/status,verbs=get
+// +kubebuilder:rbac:groups=ray.io,resources=rayjobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=ray.io,resources=rayclusters,verbs=get
+// +kubebuilder:rbac:groups=ray.io,resources=rayjobs/status,verbs=get
+// +kubebuilder:rbac:groups=trainer.kubeflow.org,resources=trainjobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=trainer.kubeflow.org,resources=trainjobs/status,verbs=get
 
 // Reconcile is the main reconciliation loop for Cron objects.
 // It ensures that the current state of the cluster (active workloads) matches
@@ -97,6 +197,7 @@ func (r *CronReconciler) Reconcile(ctx context.Context, req ctrl.Request) (resul
 	if err := r.client.Get(ctx, req.NamespacedName, oldCron); err != nil {
 		if apierrors.IsNotFound(err) {
 			log.Info("Skip reconciling Cron for it may have been deleted")
+			r.throttled.forget(req.NamespacedName)
 			return ctrl.Result{}, nil
 		}
 		// Requeue the request when there is an error getting the Cron object.
@@ -125,39 +226,103 @@ func (r *CronReconciler) Reconcile(ctx context.Context, req ctrl.Request) (resul
 		return ctrl.Result{}, nil
 	}
 
+	// Invalid status rules are only fixed by updating the Cron, which triggers another reconciliation.
+	rules, err := r.statusRules.Get(gvk.GroupKind(), cron.Spec.Template.StatusRules)
+	if err != nil {
+		log.Error(err, "Failed to compile workload status rules")
+		r.recorder.Eventf(cron, corev1.EventTypeWarning, "InvalidStatusRules", "Error compiling status rules: %v", err)
+		return ctrl.Result{}, nil
+	}
+
+	// No runs are created for kinds whose CRD is not installed, which are looked up again with a backoff.
+	available, err := r.watchWorkloads(ctx, cron, gvk)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !available {
+		return ctrl.Result{RequeueAfter: r.setWorkloadKindNotFound(ctx, cron, gvk)}, nil
+	}
+
 	// List all workloads owned by this Cron.
 	workloads, err := r.listWorkloads(ctx, cron)
+	if isWorkloadKindNotFound(err) {
+		return ctrl.Result{RequeueAfter: r.setWorkloadKindNotFound(ctx, cron, gvk)}, nil
+	}
 	if err != nil {
 		log.Error(err, fmt.Sprintf("Failed to list %s", gvk.Kind))
 		return ctrl.Result{}, err
 	}
 
+	now := time.Now()
+
 	// Filter workloads into active and terminated lists.
 	activeWorkloads := []client.Object{}
 	terminatedWorkloads := []client.Object{}
 	for _, workload := range workloads {
-		status, err := getJobStatus(workload)
+		status, err := getJobStatus(workload, rules)
 		if err != nil {
-			log.Error(err, fmt.Sprintf("Failed to get %s status", gvk.Kind))
+			// Workloads whose status cannot be interpreted, e.g. as status rules reference a field that is not
+			// populated yet, may still be running, so they are counted as active.
+			log.Error(err, fmt.Sprintf("Failed to get %s status", gvk.Kind), gvk.Kind, klog.KObj(workload))
+			r.recorder.Eventf(cron, corev1.EventTypeWarning, "FailedGetStatus", "Error getting status of %s %s, which is considered active: %v", gvk.Kind, workload.GetName(), err)
+			activeWorkloads = append(activeWorkloads, workload)
 			continue
 		}
 
 		switch {
 		case kubeflowutil.IsSucceeded(status) || kubeflowutil.IsFailed(status):
 			terminatedWorkloads = append(terminatedWorkloads, workload)
+		case workload.GetAnnotations()[common.AnnotationCronTimedOut] != "":
+			// Workloads suspended for exceeding the run timeout are finished.
+			terminatedWorkloads = append(terminatedWorkloads, workload)
 		default:
 			activeWorkloads = append(activeWorkloads, workload)
 		}
 	}
 	log.Info(fmt.Sprintf("%s count", gvk.Kind), "active", len(activeWorkloads), "terminated", len(terminatedWorkloads))
 
+	// Stop the active workloads that have exceeded the run timeout.
+	activeWorkloads, terminatedWorkloads, nextTimeout, err := r.stopTimedOutRuns(ctx, cron, activeWorkloads, terminatedWorkloads, now)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	// Requeue at the earliest upcoming timeout. Runs created in this reconciliation
+	// time out after the full run timeout, which is never earlier.
+	pendingResult := ctrl.Result{}
+	if cron.Spec.RunTimeout != nil {
+		pendingResult.RequeueAfter = cron.Spec.RunTimeout.Duration
+		if !nextTimeout.IsZero() {
+			pendingResult.RequeueAfter = nextTimeout.Sub(now)
+		}
+	}
+
+	// Find the failed runs pending a retry, which are kept in history until they have been retried.
+	failedRuns := getFailedRuns(cron, workloads, rules)
+
 	// Sync Cron status with active and terminated workloads.
-	if err := r.syncStatus(ctx, cron, activeWorkloads, terminatedWorkloads); err != nil {
+	if err := r.syncStatus(ctx, cron, activeWorkloads, terminatedWorkloads, failedRuns, rules); err != nil {
 		log.Error(err, "Failed to sync Cron status")
 		return ctrl.Result{}, err
 	}
 
-	now := time.Now()
+	// Stop tracking the replaced workloads that have terminated.
+	if err := r.syncReplacing(ctx, cron); err != nil {
+		log.Error(err, "Failed to sync replaced workloads")
+		return ctrl.Result{}, err
+	}
+	pendingResult = requeueWhileReplacing(cron, pendingResult)
+
+	// Get the active runs of the other Crons in the concurrency group. The Crons of a group are reconciled one
+	// at a time, so that the active runs counted from the API server stay accurate until new runs are created.
+	if cron.Spec.ConcurrencyGroup != "" {
+		unlock := r.groupLocks.lock(cron.Namespace + "/" + cron.Spec.ConcurrencyGroup)
+		defer unlock()
+	}
+	group, err := r.getConcurrencyGroup(ctx, cron, activeWorkloads)
+	if err != nil {
+		log.Error(err, "Failed to get Cron concurrency group")
+		return ctrl.Result{}, err
+	}
 
 	// Check if the Cron has been deleted.
 	if cron.DeletionTimestamp != nil {
@@ -165,77 +330,941 @@ func (r *CronReconciler) Reconcile(ctx context.Context, req ctrl.Request) (resul
 		return ctrl.Result{}, nil
 	}
 
+	// Create a run on demand if the Cron has been triggered. Runs triggered on demand are created
+	// regardless of the schedule, the time window and the maximum number of runs, but not while suspended.
+	activeWorkloads, throttled, err := r.handleTrigger(ctx, cron, group, activeWorkloads)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	pendingResult = earliestResult(pendingResult, ctrl.Result{RequeueAfter: throttled})
+	pendingResult = requeueWhileReplacing(cron, pendingResult)
+
 	// Check if the Cron has been suspended.
 	suspend := ptr.Deref(cron.Spec.Suspend, false)
 	if suspend {
 		log.Info("Cron has been suspended")
-		return ctrl.Result{}, nil
+		setSchedulingCondition(cron, metav1.ConditionFalse, v1alpha1.CronReasonSuspended, "cron is suspended")
+		return pendingResult, nil
 	}
 
 	// Check if the Cron has reached its deadline.
 	if cron.Spec.Deadline != nil && now.After(cron.Spec.Deadline.Time) {
 		log.Info("Cron has reached deadline and will not trigger scheduling anymore")
 		r.recorder.Event(cron, corev1.EventTypeNormal, "Deadline", "cron has reach deadline and stop scheduling")
-		return ctrl.Result{}, nil
+		message := fmt.Sprintf("cron has passed its deadline at %s", cron.Spec.Deadline.Format(time.RFC3339))
+		setSchedulingCondition(cron, metav1.ConditionFalse, v1alpha1.CronReasonDeadlineExceeded, message)
+		setCompleteCondition(cron, v1alpha1.CronReasonDeadlineExceeded, message)
+		return pendingResult, nil
 	}
 
-	// figure out the next times that we need to create
-	// jobs at (or anything we missed).
-	missedRun, nextRun, err := r.getNextSchedule(ctx, cron, now)
+	// Retry the failed runs whose backoff has elapsed. Retries are not counted as new runs,
+	// so failed runs are retried even if the maximum number of runs has been reached.
+	activeWorkloads, nextRetry, err := r.retryFailedRuns(ctx, cron, group, failedRuns, activeWorkloads, now)
 	if err != nil {
-		log.Error(err, "Failed to figure out CronJob schedule")
-		// we don't really care about requeuing until we get an update that
-		// fixes the schedule, so don't return an error
-		return ctrl.Result{}, nil
+		return ctrl.Result{}, err
+	}
+	if !nextRetry.IsZero() {
+		pendingResult = earliestResult(pendingResult, ctrl.Result{RequeueAfter: nextRetry.Sub(now)})
 	}
+	pendingResult = requeueWhileReplacing(cron, pendingResult)
+
+	// Check if the Cron has reached its maximum number of runs.
+	if r.reachedMaxRuns(ctx, cron) {
+		return pendingResult, nil
+	}
+
+	// Check if the Cron has reached its start time, and wait until then if not.
+	if cron.Spec.StartTime != nil && now.Before(cron.Spec.StartTime.Time) {
+		log.Info("Cron is waiting for its start time", "startTime", cron.Spec.StartTime)
+		setSchedulingCondition(cron, metav1.ConditionFalse, v1alpha1.CronReasonWaitingForStartTime,
+			fmt.Sprintf("cron is waiting to start at %s", cron.Spec.StartTime.Format(time.RFC3339)))
+		return earliestResult(ctrl.Result{RequeueAfter: cron.Spec.StartTime.Sub(now)}, pendingResult), nil
+	}
+	setSchedulingCondition(cron, metav1.ConditionTrue, v1alpha1.CronReasonScheduling, "cron is scheduling runs")
+	meta.RemoveStatusCondition(&cron.Status.Conditions, v1alpha1.CronConditionComplete)
+
+	// Get the calendars blocking runs of the Cron. No runs are created while one of them is missing or
+	// invalid, as they may be excluded by it; it is looked up again when it changes, and with a backoff.
+	calendars, err := r.getCalendars(ctx, cron)
+	var calendarErr *calendarError
+	if errors.As(err, &calendarErr) {
+		return earliestResult(ctrl.Result{RequeueAfter: r.setCalendarUnavailable(ctx, cron, calendarErr)}, pendingResult), nil
+	}
+	if err != nil {
+		log.Error(err, "Failed to get Cron calendars")
+		r.recorder.Eventf(cron, corev1.EventTypeWarning, "FailedGetCalendar", "Error getting calendars: %v", err)
+		return ctrl.Result{}, err
+	}
+	r.setCalendarAvailable(ctx, cron)
+
+	// figure out the next times that we need to create
+	// jobs at (or anything we missed) for each schedule.
+	schedules := getCronSchedules(cron)
+	pruneScheduleStatuses(cron, schedules)
+	pruneQueuedRuns(cron, schedules)
+	cron.Status.Schedule = normalizeSchedules(schedules)
+	missedRuns := make([][]time.Time, len(schedules))
+	var nextRun time.Time
+	for i, sched := range schedules {
+		missed, next, err := r.getNextSchedule(ctx, cron, sched, calendars, now)
+		if err != nil {
+			r.setInvalidSchedule(ctx, cron, sched, err)
+			// we don't really care about requeuing until we get an update that
+			// fixes the schedule, so don't return an error
+			return pendingResult, nil
+		}
+		missedRuns[i] = missed
+		if !next.IsZero() && (nextRun.IsZero() || next.Before(nextRun)) {
+			nextRun = next
+		}
+	}
+	meta.RemoveStatusCondition(&cron.Status.Conditions, v1alpha1.CronConditionInvalidSchedule)
 
 	scheduledResult := ctrl.Result{RequeueAfter: nextRun.Sub(now)}
 	log = log.WithValues("now", now, "next run", nextRun)
+	ctx = logf.IntoContext(ctx, log)
+
+	for i, sched := range schedules {
+		activeWorkloads, throttled, err = r.createMissedRuns(ctx, cron, group, sched, missedRuns[i], activeWorkloads)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		r.throttled.set(client.ObjectKeyFromObject(cron), sched.name, throttled > 0, now)
+		pendingResult = earliestResult(pendingResult, ctrl.Result{RequeueAfter: throttled})
+	}
+	pendingResult = requeueWhileReplacing(cron, pendingResult)
+
+	// Stop scheduling right away if the last run has just been created.
+	if r.reachedMaxRuns(ctx, cron) {
+		return pendingResult, nil
+	}
+	return earliestResult(scheduledResult, pendingResult), nil
+}
+
+// earliestResult returns whichever of the given results requeues the earliest.
+func earliestResult(a, b ctrl.Result) ctrl.Result {
+	if b.RequeueAfter > 0 && (a.RequeueAfter <= 0 || b.RequeueAfter < a.RequeueAfter) {
+		return b
+	}
+	return a
+}
+
+// requeueWhileReplacing returns the given result, requeued in time to check the replaced runs again
+// if the given Cron is waiting for them to terminate.
+func requeueWhileReplacing(cron *v1alpha1.Cron, result ctrl.Result) ctrl.Result {
+	if len(cron.Status.Replacing) == 0 {
+		return result
+	}
+	return earliestResult(result, ctrl.Result{RequeueAfter: replacePollInterval})
+}
+
+// reachedMaxRuns reports whether the given Cron has scheduled its maximum number of runs,
+// and marks it as complete if so.
+func (r *CronReconciler) reachedMaxRuns(ctx context.Context, cron *v1alpha1.Cron) bool {
+	if cron.Spec.MaxRuns == nil || cron.Status.RunCount < int64(*cron.Spec.MaxRuns) {
+		return false
+	}
+
+	logf.FromContext(ctx).Info("Cron has reached its maximum number of runs and will not trigger scheduling anymore", "runCount", cron.Status.RunCount)
+	message := fmt.Sprintf("cron has scheduled its maximum of %d runs", *cron.Spec.MaxRuns)
+	setSchedulingCondition(cron, metav1.ConditionFalse, v1alpha1.CronReasonMaxRunsReached, message)
+	if setCompleteCondition(cron, v1alpha1.CronReasonMaxRunsReached, message) {
+		r.recorder.Event(cron, corev1.EventTypeNormal, "MaxRunsReached", "cron has reached max runs and stop scheduling")
+	}
+	return true
+}
+
+// createMissedRuns creates workloads for the missed runs of the given schedule according to the concurrency policy,
+// and returns the active workloads including the created ones, and how long to wait before creating the remaining
+// runs if the creations are throttled. The concurrency policy is applied to the active workloads of each schedule
+// independently, and the concurrency group to the active workloads of all schedules.
+func (r *CronReconciler) createMissedRuns(ctx context.Context, cron *v1alpha1.Cron, group *concurrencyGroup, sched cronSchedule, missedRuns []time.Time, allActiveWorkloads []client.Object) ([]client.Object, time.Duration, error) {
+	log := logf.FromContext(ctx)
+	if sched.name != "" {
+		log = log.WithValues("schedule", sched.name)
+	}
+
+	gvk, err := getWorkloadGVK(cron)
+	if err != nil {
+		return allActiveWorkloads, 0, err
+	}
+	activeWorkloads := filterWorkloadsBySchedule(allActiveWorkloads, sched.name)
+
+	// Handle concurrency policy queue. Missed runs are queued, and the oldest queued run
+	// is created once the active run has finished.
+	queue := cron.Spec.ConcurrencyPolicy == v1alpha1.ConcurrentPolicyQueue
+	if queue {
+		missedRuns = r.queueMissedRuns(ctx, cron, sched.name, missedRuns, activeWorkloads)
+	}
 
 	// If we've missed a run, and we're still within the deadline to start it, we'll need to run a job.
-	if missedRun.IsZero() {
+	if len(missedRuns) == 0 {
 		log.V(1).Info("No upcoming schedules, wait until next")
-		return scheduledResult, nil
+		return allActiveWorkloads, 0, nil
 	}
 
-	log = log.WithValues("current run", missedRun)
+	// Runs beyond the maximum number of runs are never created, the oldest missed runs are created first.
+	if cron.Spec.MaxRuns != nil {
+		remaining := int64(*cron.Spec.MaxRuns) - cron.Status.RunCount
+		if remaining <= 0 {
+			log.V(1).Info("Skip creating new runs due to max runs", "runCount", cron.Status.RunCount)
+			return allActiveWorkloads, 0, nil
+		}
+		if int64(len(missedRuns)) > remaining {
+			missedRuns = missedRuns[:remaining]
+		}
+	}
 
-	// Handle concurrency policy forbid.
-	if cron.Spec.ConcurrencyPolicy == v1alpha1.ConcurrentPolicyForbid && len(activeWorkloads) > 0 {
-		log.V(1).Info(fmt.Sprintf("Skip creating new %s due to concurrency policy forbid", gvk.Kind), "active", len(activeWorkloads))
-		return scheduledResult, nil
+	// Apply the concurrency rules. The oldest missed runs are created first, and the remaining ones are
+	// created later unless they are skipped for the maximum number of active runs, or replaced by the most
+	// recent ones.
+	admission, err := r.admitRuns(ctx, cron, gvk, group, sched.name, len(missedRuns), allActiveWorkloads)
+	if err != nil {
+		return allActiveWorkloads, 0, err
 	}
+	allActiveWorkloads = admission.activeWorkloads
+	var skippedRuns []time.Time
+	switch {
+	case admission.latest:
+		missedRuns = missedRuns[len(missedRuns)-admission.count:]
+	case admission.skipExcess:
+		skippedRuns = missedRuns[admission.count:]
+		missedRuns = missedRuns[:admission.count]
+	default:
+		missedRuns = missedRuns[:admission.count]
+	}
+	if admission.count == 0 && !admission.skipExcess {
+		if admission.reason == "" {
+			log.V(1).Info(fmt.Sprintf("Wait for replaced %s to terminate before creating new %s", gvk.Kind, gvk.Kind), "replacing", len(cron.Status.Replacing))
+		} else {
+			log.V(1).Info(fmt.Sprintf("Skip creating new %s due to concurrency rules", gvk.Kind), "reason", admission.reason, "active", len(activeWorkloads))
+		}
+		return allActiveWorkloads, 0, nil
+	}
+
+	for _, missedRun := range missedRuns {
+		// Runs held back by the rate limit are not recorded as scheduled, so they are
+		// found missed again and created once the limit allows.
+		reservation, throttled := r.limiter.Reserve(cron.Namespace)
+		if throttled > 0 {
+			log.Info(fmt.Sprintf("Creating new %s is throttled", gvk.Kind), "current run", missedRun, "retryAfter", throttled)
+			return allActiveWorkloads, throttled, nil
+		}
+
+		workload, err := r.newWorkloadFromTemplate(cron, sched.name, missedRun)
+		if err != nil {
+			reservation.Cancel()
+			return allActiveWorkloads, 0, fmt.Errorf("unable to initialize %s from cron template: %v", gvk.Kind, err)
+		}
+
+		objectRef := klog.KRef(workload.GetNamespace(), workload.GetName())
+		log.Info(fmt.Sprintf("Creating %s", gvk.Kind), gvk.Kind, objectRef, "current run", missedRun)
+		if err := r.client.Create(ctx, workload); err != nil {
+			// The token of the rate limit is returned as no workload has been created with it.
+			reservation.Cancel()
+			if apierrors.IsAlreadyExists(err) {
+				log.Info(fmt.Sprintf("%s already exists", gvk.Kind), gvk.Kind, objectRef)
+			} else {
+				r.recorder.Eventf(cron, corev1.EventTypeWarning, "FailedCreate", "Error creating %s: %v", gvk.Kind, err)
+				return allActiveWorkloads, 0, err
+			}
+		} else {
+			allActiveWorkloads = append(allActiveWorkloads, workload)
+		}
+		// Queued runs have been recorded as scheduled when they were queued.
+		if queue {
+			removeQueuedRun(cron, sched.name, missedRun)
+		} else {
+			setLastScheduleTime(cron, sched.name, missedRun)
+		}
+		cron.Status.RunCount++
+
+		// The concurrency policy may have been overridden when creating the workload.
+		if cron.Spec.ConcurrencyPolicy == v1alpha1.ConcurrentPolicyForbid {
+			break
+		}
+	}
+
+	for _, skippedRun := range skippedRuns {
+		log.Info(fmt.Sprintf("Skip creating new %s due to max active runs", gvk.Kind), "active", len(activeWorkloads), "current run", skippedRun)
+		r.recordSkippedRun(cron, sched.name, skippedRun, v1alpha1.SkipReasonMaxActiveRunsReached,
+			fmt.Sprintf("run scheduled at %s was skipped as the maximum of %d runs are active", skippedRun.Format(time.RFC3339), *cron.Spec.MaxActiveRuns))
+		setLastScheduleTime(cron, sched.name, skippedRun)
+	}
+	return allActiveWorkloads, 0, nil
+}
+
+// queueMissedRuns queues the missed runs of the given schedule with the Queue concurrency policy, recording
+// them as scheduled, and skips the ones missed while the queue is full. It returns the oldest queued run of
+// the schedule to be created if the schedule has no active runs.
+func (r *CronReconciler) queueMissedRuns(ctx context.Context, cron *v1alpha1.Cron, schedule string, missedRuns []time.Time, activeWorkloads []client.Object) []time.Time {
+	log := logf.FromContext(ctx)
+	limit := int(ptr.Deref(cron.Spec.MaxQueuedRuns, defaultMaxQueuedRuns))
+	// Without active runs, the oldest queued run is created right away instead of waiting in the queue.
+	capacity := limit
+	if len(activeWorkloads) == 0 {
+		capacity++
+	}
+
+	queued := []time.Time{}
+	for _, run := range cron.Status.QueuedRuns {
+		if run.Schedule == schedule {
+			queued = append(queued, run.ScheduleTime.Time)
+		}
+	}
+	for _, missedRun := range missedRuns {
+		if len(queued) >= capacity {
+			log.Info("Queue is full", "scheduled", missedRun, "queued", len(queued))
+			r.recordSkippedRun(cron, schedule, missedRun, v1alpha1.SkipReasonQueueFull,
+				fmt.Sprintf("run scheduled at %s was skipped as the maximum of %d runs are queued", missedRun.Format(time.RFC3339), limit))
+		} else {
+			log.Info("Queueing run", "scheduled", missedRun, "queued", len(queued))
+			cron.Status.QueuedRuns = append(cron.Status.QueuedRuns, v1alpha1.CronQueuedRun{
+				ScheduleTime: metav1.NewTime(missedRun),
+				Schedule:     schedule,
+			})
+			queued = append(queued, missedRun)
+		}
+		setLastScheduleTime(cron, schedule, missedRun)
+	}
+
+	if len(queued) == 0 || len(activeWorkloads) > 0 {
+		return nil
+	}
+	return queued[:1]
+}
 
-	// Handle concurrency policy replace.
+// runAdmission is the outcome of applying the concurrency rules of a Cron to new runs of one of its schedules.
+type runAdmission struct {
+	// count is the number of new runs that can be created now.
+	count int
+	// latest reports whether the most recent of the new runs are created rather than the oldest ones,
+	// as they replace the older ones.
+	latest bool
+	// skipExcess reports whether the new runs beyond count are skipped rather than created later.
+	skipExcess bool
+	// reason is the skip reason of the concurrency rule limiting the new runs, which is empty while
+	// waiting for replaced runs to terminate.
+	reason string
+	// activeWorkloads are the active workloads of all schedules without the ones deleted to make room.
+	activeWorkloads []client.Object
+}
+
+// admitRuns applies the concurrency rules of the given Cron to the given number of new runs of the given
+// schedule, in order: the concurrency group, the concurrency policy and the maximum number of active runs.
+// The concurrency policy and the maximum number of active runs apply to the active workloads of the schedule,
+// or of all schedules for runs without a schedule, and the concurrency group to the active workloads of all
+// schedules. Active workloads are deleted when the rules replace them with the new runs.
+func (r *CronReconciler) admitRuns(ctx context.Context, cron *v1alpha1.Cron, gvk schema.GroupVersionKind, group *concurrencyGroup, schedule string, runs int, allActiveWorkloads []client.Object) (runAdmission, error) {
+	admission := runAdmission{count: runs, activeWorkloads: allActiveWorkloads}
+	activeWorkloads := filterWorkloadsBySchedule(allActiveWorkloads, schedule)
+	replace := cron.Spec.ConcurrencyPolicy == v1alpha1.ConcurrentPolicyReplace
+
+	// New runs wait while the concurrency group is full, and the active runs replaced with the Replace
+	// concurrency policy make room in it.
+	if group != nil {
+		others := allActiveWorkloads
+		if replace {
+			others = withoutWorkloads(allActiveWorkloads, activeWorkloads)
+		}
+		available := group.available(others)
+		if available <= 0 {
+			return runAdmission{reason: v1alpha1.SkipReasonConcurrencyGroupFull, activeWorkloads: allActiveWorkloads}, nil
+		}
+		if !replace && available < admission.count {
+			admission.count = available
+			admission.reason = v1alpha1.SkipReasonConcurrencyGroupFull
+		}
+	}
+
+	switch cron.Spec.ConcurrencyPolicy {
+	case v1alpha1.ConcurrentPolicyForbid, v1alpha1.ConcurrentPolicyQueue:
+		// New runs are created one at a time once the active runs have finished.
+		if len(activeWorkloads) > 0 {
+			return runAdmission{reason: v1alpha1.SkipReasonConcurrencyForbidden, activeWorkloads: allActiveWorkloads}, nil
+		}
+		if admission.count > 1 {
+			admission.count = 1
+			admission.reason = v1alpha1.SkipReasonConcurrencyForbidden
+		}
+	case v1alpha1.ConcurrentPolicyReplace:
+		replaced, err := r.replaceActiveWorkloads(ctx, cron, gvk, activeWorkloads)
+		if err != nil {
+			return runAdmission{activeWorkloads: allActiveWorkloads}, err
+		}
+		if !replaced {
+			return runAdmission{activeWorkloads: allActiveWorkloads}, nil
+		}
+		// New runs would replace each other, so only the most recent one is created.
+		admission.count = 1
+		admission.latest = true
+		admission.activeWorkloads = withoutWorkloads(allActiveWorkloads, activeWorkloads)
+	default:
+		available, ok := getAvailableActiveRuns(cron, activeWorkloads)
+		if !ok {
+			break
+		}
+		if cron.Spec.MaxActiveRunsPolicy == v1alpha1.MaxActiveRunsPolicyReplaceOldest {
+			// New runs beyond the maximum would replace each other, so only the most recent ones are created.
+			admission.count = min(admission.count, int(*cron.Spec.MaxActiveRuns))
+			admission.latest = true
+			excess := getExcessActiveRuns(cron, activeWorkloads, admission.count)
+			if err := r.deleteActiveWorkloads(ctx, gvk, excess, metav1.DeletePropagationBackground); err != nil {
+				return runAdmission{activeWorkloads: allActiveWorkloads}, err
+			}
+			admission.activeWorkloads = withoutWorkloads(allActiveWorkloads, excess)
+		} else if available < admission.count {
+			admission.count = max(available, 0)
+			admission.skipExcess = true
+			admission.reason = v1alpha1.SkipReasonMaxActiveRunsReached
+		}
+	}
+	return admission, nil
+}
+
+// deleteActiveWorkloads deletes the given active workloads to replace them with a new run.
+func (r *CronReconciler) deleteActiveWorkloads(ctx context.Context, gvk schema.GroupVersionKind, activeWorkloads []client.Object, propagation metav1.DeletionPropagation) error {
+	log := logf.FromContext(ctx)
+	for _, workload := range activeWorkloads {
+		// we don't care if the job was already deleted
+		objectRef := klog.KRef(workload.GetNamespace(), workload.GetName())
+		log.Info(fmt.Sprintf("Deleting active %s", gvk.Kind), gvk.Kind, objectRef)
+		if err := r.client.Delete(ctx, workload, client.PropagationPolicy(propagation)); client.IgnoreNotFound(err) != nil {
+			log.Error(err, fmt.Sprintf("Failed to delete active %s", gvk.Kind), gvk.Kind, objectRef)
+			return err
+		}
+	}
+	return nil
+}
+
+// replaceActiveWorkloads deletes the given active workloads to replace them with a new run, tracking them in
+// Cron status, and reports whether the new run can be created because the replaced workloads are gone, or
+// because the replace timeout has elapsed. Replaced workloads are deleted in the foreground, so that they are
+// only gone once the objects they own, down to their pods, are gone as well.
+func (r *CronReconciler) replaceActiveWorkloads(ctx context.Context, cron *v1alpha1.Cron, gvk schema.GroupVersionKind, activeWorkloads []client.Object) (bool, error) {
+	log := logf.FromContext(ctx)
+	policy := ptr.Deref(cron.Spec.ReplacePolicy, v1alpha1.CronReplacePolicy{})
+
+	for _, workload := range activeWorkloads {
+		if slices.ContainsFunc(cron.Status.Replacing, func(ref corev1.ObjectReference) bool {
+			return ref.UID == workload.GetUID()
+		}) {
+			continue
+		}
+		if err := r.deleteActiveWorkloads(ctx, gvk, []client.Object{workload}, metav1.DeletePropagationForeground); err != nil {
+			return false, err
+		}
+		if policy.GracePeriodSeconds != nil {
+			if err := r.deleteWorkloadPods(ctx, cron, workload, *policy.GracePeriodSeconds); err != nil {
+				return false, err
+			}
+		}
+		cron.Status.Replacing = append(cron.Status.Replacing, corev1.ObjectReference{
+			APIVersion: gvk.GroupVersion().String(),
+			Kind:       gvk.Kind,
+			Name:       workload.GetName(),
+			Namespace:  workload.GetNamespace(),
+			UID:        workload.GetUID(),
+		})
+	}
+
+	if err := r.syncReplacing(ctx, cron); err != nil {
+		return false, err
+	}
+	if len(cron.Status.Replacing) == 0 {
+		return true, nil
+	}
+
+	names := make([]string, len(cron.Status.Replacing))
+	for i, ref := range cron.Status.Replacing {
+		names[i] = ref.Name
+	}
+	condition := meta.FindStatusCondition(cron.Status.Conditions, v1alpha1.CronConditionReplacing)
+	if condition == nil {
+		log.Info(fmt.Sprintf("Waiting for replaced %s to terminate", gvk.Kind), "replacing", names)
+		r.recorder.Eventf(cron, corev1.EventTypeNormal, "Replacing", "Waiting for %s %s to terminate before creating a new run", gvk.Kind, strings.Join(names, ", "))
+		meta.SetStatusCondition(&cron.Status.Conditions, metav1.Condition{
+			Type:               v1alpha1.CronConditionReplacing,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: cron.Generation,
+			Reason:             v1alpha1.CronReasonWaitingForTermination,
+			Message:            fmt.Sprintf("waiting for %s %s and their pods to be gone", gvk.Kind, strings.Join(names, ", ")),
+		})
+		return false, nil
+	}
+
+	timeout := defaultReplaceTimeout
+	if policy.Timeout != nil {
+		timeout = policy.Timeout.Duration
+	}
+	if time.Since(condition.LastTransitionTime.Time) < timeout {
+		return false, nil
+	}
+	log.Info(fmt.Sprintf("Timed out waiting for replaced %s to terminate", gvk.Kind), "replacing", names, "timeout", timeout)
+	r.recorder.Eventf(cron, corev1.EventTypeWarning, "ReplaceTimeout", "Timed out after %s waiting for %s %s to terminate, creating a new run anyway",
+		timeout, gvk.Kind, strings.Join(names, ", "))
+	cron.Status.Replacing = nil
+	meta.RemoveStatusCondition(&cron.Status.Conditions, v1alpha1.CronConditionReplacing)
+	return true, nil
+}
+
+// syncReplacing stops tracking the replaced workloads that are gone, and all of them once the concurrency
+// policy is no longer Replace.
+func (r *CronReconciler) syncReplacing(ctx context.Context, cron *v1alpha1.Cron) error {
+	var remaining []corev1.ObjectReference
 	if cron.Spec.ConcurrencyPolicy == v1alpha1.ConcurrentPolicyReplace {
-		for _, workload := range activeWorkloads {
-			// we don't care if the job was already deleted
-			objectRef := klog.KRef(workload.GetNamespace(), workload.GetName())
-			log.Info(fmt.Sprintf("Deleting active %s", gvk.Kind), gvk.Kind, objectRef)
-			if err := r.client.Delete(ctx, workload, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
-				log.Error(err, fmt.Sprintf("Failed to delete active %s", gvk.Kind), gvk.Kind, objectRef)
-				return ctrl.Result{}, err
+		for _, ref := range cron.Status.Replacing {
+			gone, err := r.isWorkloadGone(ctx, ref)
+			if err != nil {
+				return err
+			}
+			if !gone {
+				remaining = append(remaining, ref)
 			}
 		}
 	}
+	cron.Status.Replacing = remaining
+	if len(remaining) == 0 {
+		meta.RemoveStatusCondition(&cron.Status.Conditions, v1alpha1.CronConditionReplacing)
+	}
+	return nil
+}
+
+// deleteWorkloadPods deletes the pods of the given workload of the given Cron with the given grace period.
+// Pods are selected by the Cron name label set on the pod templates of the workload, and belong to the
+// workload when it controls them directly or through their controllers, e.g. the Jobs of a JobSet.
+func (r *CronReconciler) deleteWorkloadPods(ctx context.Context, cron *v1alpha1.Cron, workload client.Object, gracePeriodSeconds int64) error {
+	pods := &corev1.PodList{}
+	if err := r.reader.List(ctx, pods, client.InNamespace(workload.GetNamespace()), client.MatchingLabels{common.LabelCronName: cron.Name}); err != nil {
+		return err
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		controlled, err := r.isControlledBy(ctx, pod, workload.GetUID())
+		if err != nil {
+			return err
+		}
+		if !controlled {
+			continue
+		}
+		if err := r.client.Delete(ctx, pod, client.GracePeriodSeconds(gracePeriodSeconds)); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isControlledBy reports whether the given object is controlled by the object with the given UID, directly or
+// through the chain of its controllers up to maxOwnerDepth of them.
+func (r *CronReconciler) isControlledBy(ctx context.Context, obj client.Object, uid types.UID) (bool, error) {
+	for range maxOwnerDepth {
+		ref := metav1.GetControllerOf(obj)
+		if ref == nil {
+			return false, nil
+		}
+		if ref.UID == uid {
+			return true, nil
+		}
+		owner := &metav1.PartialObjectMetadata{}
+		owner.SetGroupVersionKind(schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind))
+		if err := r.reader.Get(ctx, types.NamespacedName{Namespace: obj.GetNamespace(), Name: ref.Name}, owner); err != nil {
+			return false, client.IgnoreNotFound(err)
+		}
+		obj = owner
+	}
+	return false, nil
+}
 
-	workload, err := r.newWorkloadFromTemplate(cron, nextRun)
+// isWorkloadGone reports whether the referenced workload has been deleted. As it is deleted in the foreground,
+// it is only gone once the objects it owns are gone as well.
+func (r *CronReconciler) isWorkloadGone(ctx context.Context, ref corev1.ObjectReference) (bool, error) {
+	workload := &metav1.PartialObjectMetadata{}
+	workload.SetGroupVersionKind(schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind))
+	err := r.reader.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, workload)
+	if apierrors.IsNotFound(err) {
+		return true, nil
+	}
 	if err != nil {
-		return ctrl.Result{}, fmt.Errorf("unable to initialize %s from cron template: %v", gvk.Kind, err)
+		return false, err
+	}
+	return workload.GetUID() != ref.UID, nil
+}
+
+// stopTimedOutRuns stops the active workloads that have exceeded the run timeout, suspending the ones
+// supporting suspension and deleting the others. It returns the remaining active workloads, the terminated
+// workloads including the suspended ones, and the earliest upcoming timeout of the remaining active workloads.
+func (r *CronReconciler) stopTimedOutRuns(ctx context.Context, cron *v1alpha1.Cron, activeWorkloads, terminatedWorkloads []client.Object, now time.Time) ([]client.Object, []client.Object, time.Time, error) {
+	if cron.Spec.RunTimeout == nil {
+		return activeWorkloads, terminatedWorkloads, time.Time{}, nil
 	}
+	log := logf.FromContext(ctx)
+	timeout := cron.Spec.RunTimeout.Duration
+
+	var nextTimeout time.Time
+	remaining := []client.Object{}
+	for _, workload := range activeWorkloads {
+		// Workloads being deleted have already been stopped.
+		if workload.GetDeletionTimestamp() != nil {
+			remaining = append(remaining, workload)
+			continue
+		}
+
+		timeoutTime := workload.GetCreationTimestamp().Add(timeout)
+		if timeoutTime.After(now) {
+			if nextTimeout.IsZero() || timeoutTime.Before(nextTimeout) {
+				nextTimeout = timeoutTime
+			}
+			remaining = append(remaining, workload)
+			continue
+		}
+
+		gvk := workload.GetObjectKind().GroupVersionKind()
+		objectRef := klog.KObj(workload)
+		original := workload.DeepCopyObject().(client.Object)
+		if suspendWorkload(workload) {
+			setAnnotation(workload, common.AnnotationCronTimedOut, now.Format(time.RFC3339))
+			log.Info(fmt.Sprintf("Suspending timed out %s", gvk.Kind), gvk.Kind, objectRef, "timeout", timeout)
+			if err := r.client.Patch(ctx, workload, client.MergeFrom(original)); err != nil {
+				r.recorder.Eventf(cron, corev1.EventTypeWarning, "FailedSuspend", "Error suspending %s %s: %v", gvk.Kind, workload.GetName(), err)
+				return activeWorkloads, terminatedWorkloads, time.Time{}, err
+			}
+			r.recorder.Eventf(cron, corev1.EventTypeWarning, "TimedOut", "Suspended %s %s for exceeding the run timeout of %s", gvk.Kind, workload.GetName(), timeout)
+			terminatedWorkloads = append(terminatedWorkloads, workload)
+			continue
+		}
+
+		log.Info(fmt.Sprintf("Deleting timed out %s", gvk.Kind), gvk.Kind, objectRef, "timeout", timeout)
+		if err := r.client.Delete(ctx, workload, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+			r.recorder.Eventf(cron, corev1.EventTypeWarning, "FailedDelete", "Error deleting %s %s: %v", gvk.Kind, workload.GetName(), err)
+			return activeWorkloads, terminatedWorkloads, time.Time{}, err
+		}
+		r.recorder.Eventf(cron, corev1.EventTypeWarning, "TimedOut", "Deleted %s %s for exceeding the run timeout of %s", gvk.Kind, workload.GetName(), timeout)
+
+		// The deleted workload is no longer listed, so it is recorded in history right away.
+		entry := newCronHistory(workload, v1alpha1.JobTimedOut)
+		entry.Finished = ptr.To(metav1.NewTime(now))
+		cron.Status.History = append(cron.Status.History, entry)
+	}
+	return remaining, terminatedWorkloads, nextTimeout, nil
+}
+
+// retryFailedRuns creates a retry of each failed run whose backoff has elapsed, following the concurrency
+// policy. It returns the active workloads including the created retries, and the time of the earliest
+// retry that is not due yet or is throttled.
+func (r *CronReconciler) retryFailedRuns(ctx context.Context, cron *v1alpha1.Cron, group *concurrencyGroup, failedRuns []failedRun, activeWorkloads []client.Object, now time.Time) ([]client.Object, time.Time, error) {
+	log := logf.FromContext(ctx)
+
+	var nextRetry time.Time
+	for _, run := range failedRuns {
+		attempt := run.attempt + 1
+		retryTime := run.failedTime.Add(getRetryBackoff(cron.Spec.RetryPolicy, attempt))
+		if retryTime.After(now) {
+			if nextRetry.IsZero() || retryTime.Before(nextRetry) {
+				nextRetry = retryTime
+			}
+			continue
+		}
+
+		gvk := run.workload.GetObjectKind().GroupVersionKind()
+		failedRef := klog.KObj(run.workload)
+		schedule := run.workload.GetLabels()[common.LabelCronSchedule]
+
+		// The run is retried once the concurrency rules admit it.
+		admission, err := r.admitRuns(ctx, cron, gvk, group, schedule, 1, activeWorkloads)
+		if err != nil {
+			return activeWorkloads, nextRetry, err
+		}
+		activeWorkloads = admission.activeWorkloads
+		if admission.count == 0 {
+			log.V(1).Info(fmt.Sprintf("Skip retrying failed %s due to concurrency rules", gvk.Kind), gvk.Kind, failedRef, "reason", admission.reason)
+			continue
+		}
+
+		name := getRetryJobName(run.original, attempt)
+		workload, err := newWorkload(r.scheme, r.recorder, cron, cron, schedule, name)
+		if err != nil {
+			return activeWorkloads, nextRetry, fmt.Errorf("unable to initialize %s from cron template: %v", gvk.Kind, err)
+		}
+		// Runs of a template with a fixed name cannot be retried, as the retry would have the same name.
+		if workload.GetName() != name {
+			log.V(1).Info(fmt.Sprintf("Skip retrying failed %s with a name specified in the template", gvk.Kind), gvk.Kind, failedRef)
+			continue
+		}
+		for _, key := range []string{common.AnnotationCronScheduleTime, common.AnnotationCronTrigger} {
+			if value, ok := run.workload.GetAnnotations()[key]; ok {
+				setAnnotation(workload, key, value)
+			}
+		}
+		setAnnotation(workload, common.AnnotationCronRetryOf, run.original)
+		setAnnotation(workload, common.AnnotationCronRetryAttempt, strconv.Itoa(int(attempt)))
+
+		// The run is retried once the rate limit allows.
+		reservation, throttled := r.limiter.Reserve(cron.Namespace)
+		if throttled > 0 {
+			log.Info(fmt.Sprintf("Retrying failed %s is throttled", gvk.Kind), gvk.Kind, failedRef, "retryAfter", throttled)
+			if retryAt := now.Add(throttled); nextRetry.IsZero() || retryAt.Before(nextRetry) {
+				nextRetry = retryAt
+			}
+			break
+		}
+
+		objectRef := klog.KRef(workload.GetNamespace(), workload.GetName())
+		log.Info(fmt.Sprintf("Retrying failed %s", gvk.Kind), gvk.Kind, objectRef, "failed", failedRef, "attempt", attempt)
+		if err := r.client.Create(ctx, workload); err != nil {
+			reservation.Cancel()
+			if !apierrors.IsAlreadyExists(err) {
+				r.recorder.Eventf(cron, corev1.EventTypeWarning, "FailedCreate", "Error creating %s: %v", gvk.Kind, err)
+				return activeWorkloads, nextRetry, err
+			}
+			log.Info(fmt.Sprintf("%s already exists", gvk.Kind), gvk.Kind, objectRef)
+		}
+		r.recorder.Eventf(cron, corev1.EventTypeNormal, "Retrying", "Created %s %s to retry failed run %s, retry %d of %d",
+			gvk.Kind, workload.GetName(), run.original, attempt, cron.Spec.RetryPolicy.MaxRetries)
+		activeWorkloads = append(activeWorkloads, workload)
+	}
+	return activeWorkloads, nextRetry, nil
+}
+
+// handleTrigger creates a run on demand when the trigger annotation of the given Cron is set to a token
+// that has not been handled yet, following the concurrency policy. It returns the active workloads
+// including the created one, and how long to wait before handling the token if the creation is throttled.
+func (r *CronReconciler) handleTrigger(ctx context.Context, cron *v1alpha1.Cron, group *concurrencyGroup, activeWorkloads []client.Object) ([]client.Object, time.Duration, error) {
+	token := cron.Annotations[common.AnnotationTrigger]
+	if token == "" || token == cron.Status.LastTriggerToken {
+		return activeWorkloads, 0, nil
+	}
+	log := logf.FromContext(ctx).WithValues("trigger", token)
+	ctx = logf.IntoContext(ctx, log)
+
+	if ptr.Deref(cron.Spec.Suspend, false) {
+		log.Info("Skip creating triggered run as Cron is suspended")
+		r.recordSkippedRun(cron, "", time.Now(), v1alpha1.SkipReasonSuspended,
+			fmt.Sprintf("run triggered by token %q was skipped as the cron is suspended", token))
+		cron.Status.LastTriggerToken = token
+		return activeWorkloads, 0, nil
+	}
+
+	// Triggered runs are counted against the maximum number of runs like scheduled ones.
+	if cron.Spec.MaxRuns != nil && cron.Status.RunCount >= int64(*cron.Spec.MaxRuns) {
+		log.Info("Skip creating triggered run as Cron has reached its maximum number of runs", "runCount", cron.Status.RunCount)
+		r.recordSkippedRun(cron, "", time.Now(), v1alpha1.SkipReasonMaxRunsReached,
+			fmt.Sprintf("run triggered by token %q was skipped as the cron has scheduled its maximum of %d runs", token, *cron.Spec.MaxRuns))
+		cron.Status.LastTriggerToken = token
+		return activeWorkloads, 0, nil
+	}
+
+	gvk, err := getWorkloadGVK(cron)
+	if err != nil {
+		return activeWorkloads, 0, err
+	}
+
+	// Triggered runs have no schedule, so the concurrency rules apply to the active runs of all schedules.
+	admission, err := r.admitRuns(ctx, cron, gvk, group, "", 1, activeWorkloads)
+	if err != nil {
+		return activeWorkloads, 0, err
+	}
+	activeWorkloads = admission.activeWorkloads
+	if admission.count == 0 {
+		// The token is handled once the replaced runs have terminated.
+		if admission.reason == "" {
+			return activeWorkloads, 0, nil
+		}
+		var message string
+		switch admission.reason {
+		case v1alpha1.SkipReasonConcurrencyGroupFull:
+			message = fmt.Sprintf("run triggered by token %q was skipped as the maximum of %d runs of concurrency group %s are active", token, group.limit, cron.Spec.ConcurrencyGroup)
+		case v1alpha1.SkipReasonMaxActiveRunsReached:
+			message = fmt.Sprintf("run triggered by token %q was skipped as the maximum of %d runs are active", token, *cron.Spec.MaxActiveRuns)
+		default:
+			message = fmt.Sprintf("run triggered by token %q is forbidden by the concurrency policy while runs are active", token)
+		}
+		log.Info(fmt.Sprintf("Skip creating triggered %s due to concurrency rules", gvk.Kind), "reason", admission.reason, "active", len(activeWorkloads))
+		r.recordSkippedRun(cron, "", time.Now(), admission.reason, message)
+		cron.Status.LastTriggerToken = token
+		return activeWorkloads, 0, nil
+	}
+
+	// The token is handled once the rate limit allows creating the run.
+	reservation, throttled := r.limiter.Reserve(cron.Namespace)
+	if throttled > 0 {
+		log.Info(fmt.Sprintf("Creating triggered %s is throttled", gvk.Kind), "retryAfter", throttled)
+		return activeWorkloads, throttled, nil
+	}
+
+	workload, err := newWorkload(r.scheme, r.recorder, cron, cron, "", getTriggeredJobName(cron, token))
+	if err != nil {
+		reservation.Cancel()
+		return activeWorkloads, 0, fmt.Errorf("unable to initialize %s from cron template: %v", gvk.Kind, err)
+	}
+	setAnnotation(workload, common.AnnotationCronTrigger, token)
 
 	objectRef := klog.KRef(workload.GetNamespace(), workload.GetName())
-	log.Info(fmt.Sprintf("Creating %s", gvk.Kind), gvk.Kind, objectRef)
+	log.Info(fmt.Sprintf("Creating triggered %s", gvk.Kind), gvk.Kind, objectRef)
 	if err := r.client.Create(ctx, workload); err != nil {
-		if apierrors.IsAlreadyExists(err) {
-			log.Info(fmt.Sprintf("%s already exists", gvk.Kind), gvk.Kind, objectRef)
-		} else {
+		reservation.Cancel()
+		if !apierrors.IsAlreadyExists(err) {
 			r.recorder.Eventf(cron, corev1.EventTypeWarning, "FailedCreate", "Error creating %s: %v", gvk.Kind, err)
-			return ctrl.Result{}, err
+			return activeWorkloads, 0, err
+		}
+		log.Info(fmt.Sprintf("%s already exists", gvk.Kind), gvk.Kind, objectRef)
+	}
+	r.recorder.Eventf(cron, corev1.EventTypeNormal, "Triggered", "Created %s %s triggered by token %q", gvk.Kind, workload.GetName(), token)
+	cron.Status.LastTriggerToken = token
+	cron.Status.RunCount++
+	return append(activeWorkloads, workload), 0, nil
+}
+
+// getConcurrencyGroup returns the concurrency group of the given Cron with the active runs of the other Crons
+// in the group, or nil if the Cron is not in a group, and records the Crons holding the group in Cron status.
+// The active runs of the other Crons are counted from their workloads read from the API server rather than from
+// their status, which is only updated at the end of their reconciliations and may be stale in the cache.
+// Workloads of backfills are not counted, as backfills are only bounded by their own parallelism.
+func (r *CronReconciler) getConcurrencyGroup(ctx context.Context, cron *v1alpha1.Cron, activeWorkloads []client.Object) (*concurrencyGroup, error) {
+	if cron.Spec.ConcurrencyGroup == "" {
+		cron.Status.ConcurrencyGroupHolders = nil
+		return nil, nil
+	}
+
+	crons := &v1alpha1.CronList{}
+	if err := r.client.List(ctx, crons, client.InNamespace(cron.Namespace)); err != nil {
+		return nil, err
+	}
+
+	// The workloads of the other Crons are listed once per kind.
+	members := map[string]*v1alpha1.Cron{}
+	kinds := map[schema.GroupVersionKind][]string{}
+	for i := range crons.Items {
+		other := &crons.Items[i]
+		if other.Name == cron.Name || other.Spec.ConcurrencyGroup != cron.Spec.ConcurrencyGroup {
+			continue
+		}
+		gvk, err := getWorkloadGVK(other)
+		if err != nil {
+			continue
+		}
+		members[other.Name] = other
+		kinds[gvk] = append(kinds[gvk], other.Name)
+	}
+
+	group := &concurrencyGroup{limit: int(ptr.Deref(cron.Spec.ConcurrencyGroupLimit, 1))}
+	holders := sets.New[string]()
+	if len(activeWorkloads) > 0 {
+		holders.Insert(cron.Name)
+	}
+	for gvk, names := range kinds {
+		selector, err := labels.Parse(fmt.Sprintf("%s in (%s),!%s", common.LabelCronName, strings.Join(names, ","), common.LabelCronBackfillName))
+		if err != nil {
+			return nil, err
+		}
+		uList := &unstructured.UnstructuredList{}
+		uList.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := r.reader.List(ctx, uList, client.InNamespace(cron.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			if isWorkloadKindNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		for i := range uList.Items {
+			workload := &uList.Items[i]
+			other := members[workload.GetLabels()[common.LabelCronName]]
+			if other == nil || workload.GetDeletionTimestamp() != nil || workload.GetAnnotations()[common.AnnotationCronTimedOut] != "" {
+				continue
+			}
+			// Workloads whose status cannot be interpreted are counted as active.
+			if rules, err := r.statusRules.Get(gvk.GroupKind(), other.Spec.Template.StatusRules); err == nil {
+				if _, finished := isWorkloadFinished(workload, rules); finished {
+					continue
+				}
+			}
+			group.othersActive++
+			holders.Insert(other.Name)
+		}
+	}
+	cron.Status.ConcurrencyGroupHolders = sets.List(holders)
+	return group, nil
+}
+
+// mapCronToConcurrencyGroup maps a Cron to the other Crons of its concurrency group, so that they are
+// reconciled when its active runs change.
+func (r *CronReconciler) mapCronToConcurrencyGroup(ctx context.Context, obj client.Object) []reconcile.Request {
+	log := logf.FromContext(ctx)
+
+	cron, ok := obj.(*v1alpha1.Cron)
+	if !ok || cron.Spec.ConcurrencyGroup == "" {
+		return nil
+	}
+
+	crons := &v1alpha1.CronList{}
+	if err := r.client.List(ctx, crons, client.InNamespace(cron.Namespace)); err != nil {
+		log.Error(err, "Failed to list Crons for concurrency group", "cron", klog.KObj(cron))
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, other := range crons.Items {
+		if other.Name != cron.Name && other.Spec.ConcurrencyGroup == cron.Spec.ConcurrencyGroup {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&other)})
+		}
+	}
+	return requests
+}
+
+// watchWorkloads watches the workloads of the given kind of the template of the given Cron, and reports whether
+// the kind is available. The WorkloadKindAvailable condition is set back to True once a kind that was not
+// available is, e.g. once its CRD has been installed.
+func (r *CronReconciler) watchWorkloads(ctx context.Context, cron *v1alpha1.Cron, gvk schema.GroupVersionKind) (bool, error) {
+	log := logf.FromContext(ctx)
+
+	// The REST mapper discovers the kinds it does not know yet, so that CRDs installed later are found.
+	_, err := r.client.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err == nil {
+		err = r.watcher.watch(ctx, gvk)
+	}
+	if meta.IsNoMatchError(err) {
+		return false, nil
+	}
+	if err != nil {
+		log.Error(err, fmt.Sprintf("Failed to watch %s", gvk.Kind))
+		return false, err
+	}
+
+	// The condition is only kept once the kind has been unavailable, so that it shows the kind is available again.
+	if condition := meta.FindStatusCondition(cron.Status.Conditions, v1alpha1.CronConditionWorkloadKindAvailable); condition != nil {
+		if condition.Status != metav1.ConditionTrue {
+			log.Info(fmt.Sprintf("Resume scheduling %s as its CRD is installed", gvk.Kind), "apiVersion", gvk.GroupVersion().String())
+			r.recorder.Eventf(cron, corev1.EventTypeNormal, v1alpha1.CronReasonWorkloadKindFound, "The CRD of %s in %s is installed", gvk.Kind, gvk.GroupVersion())
 		}
+		meta.SetStatusCondition(&cron.Status.Conditions, metav1.Condition{
+			Type:               v1alpha1.CronConditionWorkloadKindAvailable,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: cron.Generation,
+			Reason:             v1alpha1.CronReasonWorkloadKindFound,
+			Message:            fmt.Sprintf("%s in %s is served by the API server", gvk.Kind, gvk.GroupVersion()),
+		})
 	}
-	cron.Status.LastScheduleTime = ptr.To(metav1.Time{Time: now})
-	return scheduledResult, nil
+	return true, nil
+}
+
+// setWorkloadKindNotFound sets the WorkloadKindAvailable condition of the given Cron to False as the CRD of the
+// given kind is not installed, and returns how long to wait before looking the kind up again. The wait grows
+// with the time the kind has been unavailable, from minWorkloadKindBackoff up to maxWorkloadKindBackoff.
+func (r *CronReconciler) setWorkloadKindNotFound(ctx context.Context, cron *v1alpha1.Cron, gvk schema.GroupVersionKind) time.Duration {
+	condition := meta.FindStatusCondition(cron.Status.Conditions, v1alpha1.CronConditionWorkloadKindAvailable)
+	if condition == nil || condition.Status != metav1.ConditionFalse {
+		logf.FromContext(ctx).Info(fmt.Sprintf("Skip scheduling %s as its CRD is not installed", gvk.Kind), "apiVersion", gvk.GroupVersion().String())
+		r.recorder.Eventf(cron, corev1.EventTypeWarning, v1alpha1.CronReasonWorkloadKindNotFound, "The CRD of %s in %s is not installed", gvk.Kind, gvk.GroupVersion())
+	}
+	meta.SetStatusCondition(&cron.Status.Conditions, metav1.Condition{
+		Type:               v1alpha1.CronConditionWorkloadKindAvailable,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: cron.Generation,
+		Reason:             v1alpha1.CronReasonWorkloadKindNotFound,
+		Message:            fmt.Sprintf("the CRD of %s in %s is not installed", gvk.Kind, gvk.GroupVersion()),
+	})
+
+	// Waiting as long as the kind has been unavailable doubles the wait after each lookup.
+	condition = meta.FindStatusCondition(cron.Status.Conditions, v1alpha1.CronConditionWorkloadKindAvailable)
+	return min(max(time.Since(condition.LastTransitionTime.Time), minWorkloadKindBackoff), maxWorkloadKindBackoff)
 }
 
 // List all workloads owned by the given Cron object.
@@ -258,15 +1287,18 @@ func (r *CronReconciler) listWorkloads(ctx context.Context, cron *v1alpha1.Cron)
 		return nil, err
 	}
 
-	workloads := make([]client.Object, len(uList.Items))
-	for i, u := range uList.Items {
-		workloads[i] = &u
+	// Workloads created for the Cron by others, e.g. backfills, are not managed by the Cron.
+	workloads := make([]client.Object, 0, len(uList.Items))
+	for _, u := range uList.Items {
+		if metav1.IsControlledBy(&u, cron) {
+			workloads = append(workloads, &u)
+		}
 	}
 	return workloads, nil
 }
 
 // Sync Cron status.
-func (r *CronReconciler) syncStatus(ctx context.Context, cron *v1alpha1.Cron, activeWorkloads []client.Object, terminatedWorkloads []client.Object) error {
+func (r *CronReconciler) syncStatus(ctx context.Context, cron *v1alpha1.Cron, activeWorkloads []client.Object, terminatedWorkloads []client.Object, failedRuns []failedRun, rules *statusrule.Program) error {
 	log := logf.FromContext(ctx)
 	log.V(1).Info("Syncing Cron status")
 
@@ -274,7 +1306,7 @@ func (r *CronReconciler) syncStatus(ctx context.Context, cron *v1alpha1.Cron, ac
 		return err
 	}
 
-	if err := r.syncCronHistory(ctx, cron, terminatedWorkloads); err != nil {
+	if err := r.syncCronHistory(ctx, cron, terminatedWorkloads, failedRuns, rules); err != nil {
 		return err
 	}
 
@@ -303,8 +1335,9 @@ func (r *CronReconciler) syncActiveList(ctx context.Context, cron *v1alpha1.Cron
 	return nil
 }
 
-// Sync Cron history.
-func (r *CronReconciler) syncCronHistory(ctx context.Context, cron *v1alpha1.Cron, terminatedWorkloads []client.Object) error {
+// Sync Cron history. Failed runs pending a retry are kept regardless of the history limit, and
+// records of runs deleted for exceeding the run timeout are kept from the previous history.
+func (r *CronReconciler) syncCronHistory(ctx context.Context, cron *v1alpha1.Cron, terminatedWorkloads []client.Object, failedRuns []failedRun, rules *statusrule.Program) error {
 	log := logf.FromContext(ctx)
 	log.V(1).Info("Syncing Cron history")
 
@@ -316,23 +1349,22 @@ func (r *CronReconciler) syncCronHistory(ctx context.Context, cron *v1alpha1.Cro
 	for i, workload := range terminatedWorkloads {
 		gvk := workload.GetObjectKind().GroupVersionKind()
 		objectRef := klog.KRef(workload.GetNamespace(), workload.GetName())
-		if i < n-historyLimit {
+		pendingRetry := slices.ContainsFunc(failedRuns, func(run failedRun) bool {
+			return run.workload.GetName() == workload.GetName()
+		})
+		if i < n-historyLimit && !pendingRetry {
 			log.Info(fmt.Sprintf("Deleting terminated %s", gvk.Kind), gvk.Kind, objectRef)
 			if err := r.client.Delete(ctx, workload, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
 				log.Error(err, fmt.Sprintf("Failed to delete terminated %s", gvk.Kind), gvk.Kind, objectRef)
 			}
 		} else {
-			status, finished := isWorkloadFinished(workload)
-			entry := v1alpha1.CronHistory{
-				UID: workload.GetUID(),
-				Object: corev1.TypedLocalObjectReference{
-					// For backward compatibility, we pass group/version instead of just group.
-					APIGroup: ptr.To(gvk.GroupVersion().String()),
-					Kind:     gvk.Kind,
-					Name:     workload.GetName(),
-				},
-				Status:  status,
-				Created: ptr.To(workload.GetCreationTimestamp()),
+			status, finished := isWorkloadFinished(workload, rules)
+			if workload.GetAnnotations()[common.AnnotationCronTimedOut] != "" {
+				status, finished = v1alpha1.JobTimedOut, true
+			}
+			entry := newCronHistory(workload, status)
+			if u, ok := workload.(*unstructured.Unstructured); ok && status != v1alpha1.JobTimedOut {
+				entry.StatusInterpreter = getStatusInterpreter(u, rules)
 			}
 			if finished {
 				entry.Finished = ptr.To(metav1.Now())
@@ -341,12 +1373,64 @@ func (r *CronReconciler) syncCronHistory(ctx context.Context, cron *v1alpha1.Cro
 		}
 	}
 
+	// Records of deleted runs count towards the history limit, and only the most recent ones are kept.
+	var deleted []v1alpha1.CronHistory
+	for _, entry := range cron.Status.History {
+		if entry.Status == v1alpha1.JobTimedOut && !slices.ContainsFunc(terminatedWorkloads, func(workload client.Object) bool {
+			return workload.GetUID() == entry.UID
+		}) {
+			deleted = append(deleted, entry)
+		}
+	}
+	if n := max(historyLimit-len(history), 0); len(deleted) > n {
+		deleted = deleted[len(deleted)-n:]
+	}
+	history = append(deleted, history...)
+	slices.SortStableFunc(history, func(a, b v1alpha1.CronHistory) int {
+		return ptr.Deref(a.Created, metav1.Time{}).Compare(ptr.Deref(b.Created, metav1.Time{}).Time)
+	})
+
 	cron.Status.History = history
 	return nil
 }
 
-// newWorkloadFromTemplate creates a new workload from a cron template.
-func (r *CronReconciler) newWorkloadFromTemplate(cron *v1alpha1.Cron, scheduleTime time.Time) (client.Object, error) {
+// recordSkippedRun records a scheduled run that was skipped without creating a workload
+// in Cron status and emits an event for it. A run that has already been recorded is ignored.
+func (r *CronReconciler) recordSkippedRun(cron *v1alpha1.Cron, schedule string, scheduleTime time.Time, reason string, message string) {
+	for _, run := range cron.Status.SkippedRuns {
+		if run.Schedule == schedule && run.ScheduleTime.Unix() == scheduleTime.Unix() && run.Reason == reason {
+			return
+		}
+	}
+
+	if schedule != "" {
+		message = fmt.Sprintf("schedule %s: %s", schedule, message)
+	}
+	r.recorder.Event(cron, corev1.EventTypeWarning, reason, message)
+	cron.Status.SkippedRuns = append(cron.Status.SkippedRuns, v1alpha1.CronSkippedRun{
+		ScheduleTime: metav1.NewTime(scheduleTime),
+		Reason:       reason,
+		Message:      message,
+		Schedule:     schedule,
+	})
+	if n := len(cron.Status.SkippedRuns); n > maxSkippedRuns {
+		cron.Status.SkippedRuns = cron.Status.SkippedRuns[n-maxSkippedRuns:]
+	}
+}
+
+// newWorkloadFromTemplate creates a new workload from a cron template for a run of the given schedule.
+func (r *CronReconciler) newWorkloadFromTemplate(cron *v1alpha1.Cron, schedule string, scheduleTime time.Time) (client.Object, error) {
+	w, err := newWorkload(r.scheme, r.recorder, cron, cron, schedule, getScheduleJobName(cron, schedule, scheduleTime))
+	if err != nil {
+		return nil, err
+	}
+	setAnnotation(w, common.AnnotationCronScheduleTime, scheduleTime.Format(time.RFC3339))
+	return w, nil
+}
+
+// newWorkload creates a new workload from a cron template for a run of the given schedule, controlled
+// by the given owner and named after the given name unless a name is specified in the template.
+func newWorkload(scheme *runtime.Scheme, recorder record.EventRecorder, cron *v1alpha1.Cron, owner metav1.Object, schedule string, name string) (client.Object, error) {
 	w, err := newEmptyWorkload(cron)
 	if err != nil {
 		return nil, err
@@ -363,9 +1447,9 @@ func (r *CronReconciler) newWorkloadFromTemplate(cron *v1alpha1.Cron, scheduleTi
 
 	// Set name if not specified.
 	if len(w.GetName()) == 0 {
-		w.SetName(getDefaultJobName(cron, scheduleTime))
+		w.SetName(name)
 	} else {
-		r.recorder.Event(cron, corev1.EventTypeNormal, "OverridePolicy", "metadata.name has been specified in workload template, override cron concurrency policy as Forbidden")
+		recorder.Event(cron, corev1.EventTypeNormal, "OverridePolicy", "metadata.name has been specified in workload template, override cron concurrency policy as Forbidden")
 		cron.Spec.ConcurrencyPolicy = v1alpha1.ConcurrentPolicyForbid
 	}
 	w.SetNamespace(cron.Namespace)
@@ -376,42 +1460,110 @@ func (r *CronReconciler) newWorkloadFromTemplate(cron *v1alpha1.Cron, scheduleTi
 		labels = map[string]string{}
 	}
 	labels[common.LabelCronName] = cron.Name
+	if schedule != "" {
+		labels[common.LabelCronSchedule] = schedule
+	}
 	w.SetLabels(labels)
+	// The Cron name is set on the pod templates as well, so that the pods of the workload can be selected.
+	if u, ok := w.(*unstructured.Unstructured); ok {
+		setPodTemplateLabel(u.Object, common.LabelCronName, cron.Name)
+	}
 
 	// Set controller owner reference.
-	if err := controllerutil.SetControllerReference(cron, w, r.scheme); err != nil {
+	if err := controllerutil.SetControllerReference(owner, w, scheme); err != nil {
 		return nil, fmt.Errorf("failed to set controller owner reference: %v", err)
 	}
 
 	return w, err
 }
 
-func (r *CronReconciler) getNextSchedule(ctx context.Context, cron *v1alpha1.Cron, now time.Time) (lastMissed time.Time, next time.Time, err error) {
+// getNextSchedule returns the missed runs of the given schedule that need to be created according
+// to the catch-up policy, oldest first, and the next scheduled time after now that is not blocked
+// by the calendars. Blocked runs are skipped and recorded in status.
+func (r *CronReconciler) getNextSchedule(ctx context.Context, cron *v1alpha1.Cron, cronSched cronSchedule, calendars calendar.Calendars, now time.Time) (missedRuns []time.Time, next time.Time, err error) {
 	log := logf.FromContext(ctx)
 
-	sched, err := cronv3.ParseStandard(cron.Spec.Schedule)
+	sched, err := schedule.Parse(cronSched.schedule, cron.Spec.ScheduleFormat, cron.Spec.TimeZone)
 	if err != nil {
-		return time.Time{}, time.Time{}, fmt.Errorf("unparsable cron %q: %w", cron.Spec.Schedule, err)
+		return nil, time.Time{}, fmt.Errorf("unparsable cron %q: %w", cronSched.schedule, err)
+	}
+	cron.Status.TimeZone = schedule.TimeZone(sched)
+
+	// Runs are delayed by the jitter offset of the Cron, so scheduled times are compared
+	// with the current time shifted back by the offset.
+	offset := getJitterOffset(cron)
+	now = now.Add(-offset)
+	// Runs held back by the rate limit are late from the time their creation was first throttled,
+	// so that they are not skipped for waiting for the limit.
+	lateNow := now
+	if since, ok := r.throttled.get(client.ObjectKeyFromObject(cron), cronSched.name); ok && since.Add(-offset).Before(now) {
+		lateNow = since.Add(-offset)
 	}
 
 	var earliestTime time.Time
-	if cron.Status.LastScheduleTime != nil {
-		earliestTime = cron.Status.LastScheduleTime.Time
+	if lastScheduleTime := getLastScheduleTime(cron, cronSched.name); lastScheduleTime != nil {
+		earliestTime = lastScheduleTime.Time
 	} else {
 		earliestTime = cron.CreationTimestamp.Time
 	}
+	// Runs scheduled before the start time are never created. Scheduled times are looked for
+	// strictly after the earliest time, so it is moved just before the start time to keep a run
+	// scheduled exactly at the start time.
+	if cron.Spec.StartTime != nil {
+		if startTime := cron.Spec.StartTime.Add(-time.Nanosecond); startTime.After(earliestTime) {
+			earliestTime = startTime
+		}
+	}
 
 	if earliestTime.After(now) {
-		return time.Time{}, sched.Next(now), nil
+		return nil, getNextAllowedRun(sched, calendars, earliestTime, offset), nil
+	}
+
+	// Runs scheduled before the starting deadline window can no longer be started, so they are skipped
+	// rather than looked for as missed runs. Only the latest of them is recorded, so that the scheduled
+	// times since the last run are not all looked at on each reconciliation.
+	var lateRun time.Time
+	var blockedRuns []time.Time
+	if cron.Spec.StartingDeadlineSeconds != nil {
+		schedulingDeadline := lateNow.Add(-time.Duration(*cron.Spec.StartingDeadlineSeconds) * time.Second)
+		if schedulingDeadline.After(earliestTime) {
+			// Runs blocked by calendars are skipped either way.
+			if t := getLastScheduleTimeBefore(sched, earliestTime, schedulingDeadline); !t.IsZero() {
+				if _, _, blocked := calendars.Blocks(t.Add(offset)); blocked {
+					blockedRuns = append(blockedRuns, t)
+				} else {
+					lateRun = t
+				}
+			}
+			earliestTime = schedulingDeadline
+		}
 	}
 
+	// Only the most recent missed runs within the catch-up limit are kept.
+	catchUpLimit := 1
+	if cron.Spec.CatchUpPolicy == v1alpha1.CatchUpPolicyAll {
+		catchUpLimit = int(ptr.Deref(cron.Spec.MaxCatchUpRuns, defaultMaxCatchUpRuns))
+	}
+
+	var lastDropped time.Time
 	missedTimes := 0
 	for t := sched.Next(earliestTime); !t.After(now); t = sched.Next(t) {
 		if t.IsZero() {
-			return time.Time{}, time.Time{}, fmt.Errorf("unschedulable cron %q: %w", cron.Spec.Schedule, err)
+			return nil, time.Time{}, fmt.Errorf("unschedulable cron %q: %w", cronSched.schedule, err)
 		}
 
-		lastMissed = t
+		missedTimes++
+		// Runs blocked by calendars are skipped rather than caught up, only the most recent ones are recorded.
+		if _, _, blocked := calendars.Blocks(t.Add(offset)); blocked {
+			blockedRuns = appendRecentRun(blockedRuns, t)
+			continue
+		}
+
+		missedRuns = append(missedRuns, t)
+		if len(missedRuns) > catchUpLimit {
+			lastDropped = missedRuns[0]
+			missedRuns = missedRuns[1:]
+		}
 		// An object might miss several starts. For example, if
 		// controller gets wedged on Friday at 5:01pm when everyone has
 		// gone home, and someone comes in on Tuesday AM and discovers
@@ -426,12 +1578,209 @@ func (r *CronReconciler) getNextSchedule(ctx context.Context, cron *v1alpha1.Cro
 		// by decades or more), that it would eat up all the CPU and memory
 		// of this controller. In that case, we want to not try to list
 		// all the missed start times.
-		missedTimes++
 	}
 	if missedTimes > 100 {
 		r.recorder.Eventf(cron, corev1.EventTypeWarning, "TooManyMissedTimes", "too many missed start times: %d. Check clock skew", missedTimes)
 		log.Info("Too many missed times", "missed times", missedTimes)
 	}
 
-	return lastMissed, sched.Next(now), nil
+	if !lateRun.IsZero() {
+		log.Info("Missed run is past its starting deadline", "scheduled", lateRun)
+		r.recordSkippedRun(cron, cronSched.name, lateRun, v1alpha1.SkipReasonStartingDeadlineExceeded,
+			fmt.Sprintf("run scheduled at %s was not started within the starting deadline of %ds", lateRun.Format(time.RFC3339), *cron.Spec.StartingDeadlineSeconds))
+	}
+
+	for _, t := range blockedRuns {
+		cal, reason, _ := calendars.Blocks(t.Add(offset))
+		message := fmt.Sprintf("run scheduled at %s is blocked by calendar %s", t.Format(time.RFC3339), cal.Name())
+		if reason != "" {
+			message = fmt.Sprintf("%s: %s", message, reason)
+		}
+		log.Info("Run is blocked by calendar", "scheduled", t, "calendar", cal.Name())
+		r.recordSkippedRun(cron, cronSched.name, t, v1alpha1.SkipReasonBlockedByCalendar, message)
+	}
+
+	switch cron.Spec.CatchUpPolicy {
+	case v1alpha1.CatchUpPolicyAll:
+		if !lastDropped.IsZero() {
+			log.Info("Missed runs exceed the catch-up limit", "missed times", missedTimes, "limit", catchUpLimit)
+			r.recordSkippedRun(cron, cronSched.name, lastDropped, v1alpha1.SkipReasonCatchUpLimitExceeded,
+				fmt.Sprintf("%d missed runs up to %s were not created as they exceed the catch-up limit of %d", missedTimes-catchUpLimit, lastDropped.Format(time.RFC3339), catchUpLimit))
+		}
+	case v1alpha1.CatchUpPolicyNone:
+		// A run that is observed too long after its scheduled time was missed.
+		if len(missedRuns) > 0 && lateNow.Sub(missedRuns[0]) > catchUpTolerance {
+			log.Info("Missed run is not caught up", "scheduled", missedRuns[0])
+			r.recordSkippedRun(cron, cronSched.name, missedRuns[0], v1alpha1.SkipReasonNotCaughtUp,
+				fmt.Sprintf("run scheduled at %s was missed and the catch-up policy is None", missedRuns[0].Format(time.RFC3339)))
+			missedRuns = nil
+		}
+	}
+
+	return missedRuns, getNextAllowedRun(sched, calendars, now, offset), nil
+}
+
+// appendRecentRun appends the given scheduled time to the given ones, keeping only the most recent
+// ones that can be recorded as skipped runs in Cron status.
+func appendRecentRun(runs []time.Time, t time.Time) []time.Time {
+	runs = append(runs, t)
+	if len(runs) > maxSkippedRuns {
+		runs = runs[1:]
+	}
+	return runs
+}
+
+// getLastScheduleTimeBefore returns the latest scheduled time of the given schedule after the earliest time
+// and not after the latest time, or zero if there is none. Scheduled times are looked for back from the latest
+// time in windows doubling in length, so that not every scheduled time since the earliest time is looked at.
+func getLastScheduleTimeBefore(sched cronv3.Schedule, earliest, latest time.Time) time.Time {
+	for window := time.Second; window > 0; window *= 2 {
+		start := earliest
+		if window < latest.Sub(earliest) {
+			start = latest.Add(-window)
+		}
+
+		var last time.Time
+		for t := sched.Next(start); !t.IsZero() && !t.After(latest); t = sched.Next(t) {
+			last = t
+		}
+		if !last.IsZero() || start.Equal(earliest) {
+			return last
+		}
+	}
+	return time.Time{}
+}
+
+// getNextAllowedRun returns the next scheduled time after the given time, delayed by the jitter offset,
+// whose run is not blocked by the calendars. The scheduled times are only looked ahead for a limited
+// number of times, and the last one is returned if all of them are blocked.
+func getNextAllowedRun(sched cronv3.Schedule, calendars calendar.Calendars, t time.Time, offset time.Duration) time.Time {
+	next := sched.Next(t)
+	for i := 0; !next.IsZero() && i < maxBlockedLookahead; i++ {
+		if _, _, blocked := calendars.Blocks(next.Add(offset)); !blocked {
+			break
+		}
+		next = sched.Next(next)
+	}
+	return delayByOffset(next, offset)
+}
+
+// calendarError is returned when a calendar referenced by a Cron is missing or invalid.
+type calendarError struct {
+	// reason is the reason of the CalendarAvailable condition of the Cron.
+	reason string
+	err    error
+}
+
+func (e *calendarError) Error() string {
+	return e.err.Error()
+}
+
+func (e *calendarError) Unwrap() error {
+	return e.err
+}
+
+// getCalendars returns the calendars referenced by the given Cron, or a calendarError if one of them is
+// missing or invalid.
+func (r *CronReconciler) getCalendars(ctx context.Context, cron *v1alpha1.Cron) (calendar.Calendars, error) {
+	calendars := make(calendar.Calendars, 0, len(cron.Spec.CalendarRefs))
+	for _, ref := range cron.Spec.CalendarRefs {
+		cronCalendar := &v1alpha1.CronCalendar{}
+		if err := r.client.Get(ctx, client.ObjectKey{Namespace: cron.Namespace, Name: ref.Name}, cronCalendar); err != nil {
+			err = fmt.Errorf("failed to get calendar %s: %w", ref.Name, err)
+			if apierrors.IsNotFound(err) {
+				return nil, &calendarError{reason: v1alpha1.CronReasonCalendarNotFound, err: err}
+			}
+			return nil, err
+		}
+
+		cal, err := calendar.New(cronCalendar)
+		if err != nil {
+			return nil, &calendarError{reason: v1alpha1.CronReasonInvalidCalendar, err: fmt.Errorf("invalid calendar %s: %w", ref.Name, err)}
+		}
+		calendars = append(calendars, cal)
+	}
+	return calendars, nil
+}
+
+// setCalendarAvailable sets the CalendarAvailable condition of the given Cron to True if a calendar of the Cron
+// has been unavailable, so that it shows the calendars are available again.
+func (r *CronReconciler) setCalendarAvailable(ctx context.Context, cron *v1alpha1.Cron) {
+	condition := meta.FindStatusCondition(cron.Status.Conditions, v1alpha1.CronConditionCalendarAvailable)
+	if condition == nil {
+		return
+	}
+
+	if condition.Status != metav1.ConditionTrue {
+		logf.FromContext(ctx).Info("Resume scheduling as the calendars are available")
+		r.recorder.Event(cron, corev1.EventTypeNormal, v1alpha1.CronReasonCalendarFound, "The calendars are available")
+	}
+	meta.SetStatusCondition(&cron.Status.Conditions, metav1.Condition{
+		Type:               v1alpha1.CronConditionCalendarAvailable,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: cron.Generation,
+		Reason:             v1alpha1.CronReasonCalendarFound,
+		Message:            "the calendars exist and are valid",
+	})
+}
+
+// setCalendarUnavailable sets the CalendarAvailable condition of the given Cron to False as one of its calendars
+// is missing or invalid, and returns how long to wait before looking it up again. The wait grows with the time
+// the calendar has been unavailable, from minCalendarBackoff up to maxCalendarBackoff.
+func (r *CronReconciler) setCalendarUnavailable(ctx context.Context, cron *v1alpha1.Cron, calendarErr *calendarError) time.Duration {
+	condition := meta.FindStatusCondition(cron.Status.Conditions, v1alpha1.CronConditionCalendarAvailable)
+	if condition == nil || condition.Status != metav1.ConditionFalse || condition.Message != calendarErr.Error() {
+		logf.FromContext(ctx).Error(calendarErr, "Skip scheduling as a calendar is unavailable")
+		r.recorder.Eventf(cron, corev1.EventTypeWarning, calendarErr.reason, "Skip scheduling until the calendar is available: %v", calendarErr)
+	}
+	meta.SetStatusCondition(&cron.Status.Conditions, metav1.Condition{
+		Type:               v1alpha1.CronConditionCalendarAvailable,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: cron.Generation,
+		Reason:             calendarErr.reason,
+		Message:            calendarErr.Error(),
+	})
+
+	// Waiting as long as the calendar has been unavailable doubles the wait after each lookup.
+	condition = meta.FindStatusCondition(cron.Status.Conditions, v1alpha1.CronConditionCalendarAvailable)
+	return min(max(time.Since(condition.LastTransitionTime.Time), minCalendarBackoff), maxCalendarBackoff)
+}
+
+// setInvalidSchedule sets the InvalidSchedule condition of the given Cron as the given schedule cannot be parsed,
+// reporting it with an event when it is new.
+func (r *CronReconciler) setInvalidSchedule(ctx context.Context, cron *v1alpha1.Cron, sched cronSchedule, err error) {
+	condition := meta.FindStatusCondition(cron.Status.Conditions, v1alpha1.CronConditionInvalidSchedule)
+	if condition == nil || condition.Message != err.Error() {
+		logf.FromContext(ctx).Error(err, "Failed to figure out CronJob schedule")
+		r.recorder.Eventf(cron, corev1.EventTypeWarning, v1alpha1.CronReasonInvalidSchedule, "Error figuring out schedule %s: %v", sched.name, err)
+	}
+	meta.SetStatusCondition(&cron.Status.Conditions, metav1.Condition{
+		Type:               v1alpha1.CronConditionInvalidSchedule,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: cron.Generation,
+		Reason:             v1alpha1.CronReasonInvalidSchedule,
+		Message:            err.Error(),
+	})
+}
+
+// mapCalendarToCrons returns reconcile requests for the Crons referencing the given CronCalendar.
+func (r *CronReconciler) mapCalendarToCrons(ctx context.Context, obj client.Object) []reconcile.Request {
+	log := logf.FromContext(ctx)
+
+	crons := &v1alpha1.CronList{}
+	if err := r.client.List(ctx, crons, client.InNamespace(obj.GetNamespace())); err != nil {
+		log.Error(err, "Failed to list Crons for calendar", "calendar", klog.KObj(obj))
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, cron := range crons.Items {
+		for _, ref := range cron.Spec.CalendarRefs {
+			if ref.Name == obj.GetName() {
+				requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&cron)})
+				break
+			}
+		}
+	}
+	return requests
 }
@@ -24,15 +24,25 @@ import (
 	. "github.com/onsi/gomega"
 
 	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/AliyunContainerService/cron-operator/api/v1alpha1"
+	"github.com/AliyunContainerService/cron-operator/internal/calendar"
+	"github.com/AliyunContainerService/cron-operator/internal/ratelimit"
+	"github.com/AliyunContainerService/cron-operator/internal/statusrule"
 	"github.com/AliyunContainerService/cron-operator/pkg/common"
 )
 
@@ -82,13 +92,60 @@ var _ = Describe("Cron Controller", func() {
 		})
 
 		It("should successfully reconcile the resource", func() {
-			r := NewCronReconciler(scheme, k8sClient, k8sClient, nil)
+			r := NewCronReconciler(scheme, k8sClient, k8sClient, nil, nil, nil)
 			_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
 			Expect(err).NotTo(HaveOccurred())
 		})
 
+		It("should reject invalid specs without the webhook", func() {
+			cron := &v1alpha1.Cron{}
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			cron.Spec.Jitter = &metav1.Duration{Duration: -time.Second}
+			err := k8sClient.Update(ctx, cron)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("must be non-negative")))
+
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			cron.Spec.MaxActiveRuns = ptr.To[int32](2)
+			err = k8sClient.Update(ctx, cron)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("maxActiveRuns may only be specified with the Allow concurrency policy")))
+		})
+
+		It("should report invalid time zones without the webhook", func() {
+			recorder := record.NewFakeRecorder(10)
+			r := NewCronReconciler(scheme, k8sClient, k8sClient, recorder, nil, nil)
+
+			cron := &v1alpha1.Cron{}
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			cron.Spec.TimeZone = ptr.To("Mars/Olympus_Mons")
+			Expect(k8sClient.Update(ctx, cron)).To(Succeed())
+
+			_, err := r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			condition := meta.FindStatusCondition(cron.Status.Conditions, v1alpha1.CronConditionInvalidSchedule)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(condition.Message).To(ContainSubstring("Mars/Olympus_Mons"))
+			Expect(recorder.Events).To(Receive(ContainSubstring(v1alpha1.CronReasonInvalidSchedule)))
+
+			// The condition is reported once, and removed once the time zone is fixed.
+			_, err = r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recorder.Events).NotTo(Receive())
+
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			cron.Spec.TimeZone = ptr.To("Asia/Shanghai")
+			Expect(k8sClient.Update(ctx, cron)).To(Succeed())
+			_, err = r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			Expect(meta.FindStatusCondition(cron.Status.Conditions, v1alpha1.CronConditionInvalidSchedule)).To(BeNil())
+		})
+
 		It("should create a workload when schedule matches", func() {
-			r := NewCronReconciler(scheme, k8sClient, k8sClient, nil)
+			r := NewCronReconciler(scheme, k8sClient, k8sClient, nil, nil, nil)
 
 			// Mock LastScheduleTime to be 2 minutes ago so it triggers now
 			cron := &v1alpha1.Cron{}
@@ -96,36 +153,929 @@ var _ = Describe("Cron Controller", func() {
 			cron.Status.LastScheduleTime = &metav1.Time{Time: time.Now().Add(-2 * time.Minute)}
 			Expect(k8sClient.Status().Update(ctx, cron)).To(Succeed())
 
-			_, err := r.Reconcile(ctx, req)
+			_, err := r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Check if PyTorchJob was created
+			uList := &unstructured.UnstructuredList{}
+			uList.SetGroupVersionKind(kubeflowv1.SchemeGroupVersion.WithKind("PyTorchJob"))
+			Eventually(func() int {
+				_ = k8sClient.List(ctx, uList, client.InNamespace(namespace), client.MatchingLabels{common.LabelCronName: name})
+				return len(uList.Items)
+			}, time.Second*5, time.Millisecond*500).Should(BeNumerically(">=", 1))
+		})
+
+		It("should create a workload for each missed run with catch-up policy All", func() {
+			r := NewCronReconciler(scheme, k8sClient, k8sClient, record.NewFakeRecorder(10), nil, nil)
+
+			cron := &v1alpha1.Cron{}
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			cron.Spec.ConcurrencyPolicy = v1alpha1.ConcurrentPolicyAllow
+			cron.Spec.CatchUpPolicy = v1alpha1.CatchUpPolicyAll
+			Expect(k8sClient.Update(ctx, cron)).To(Succeed())
+			lastScheduleTime := time.Now().Add(-3 * time.Minute)
+			cron.Status.LastScheduleTime = &metav1.Time{Time: lastScheduleTime}
+			Expect(k8sClient.Status().Update(ctx, cron)).To(Succeed())
+
+			// Each missed run should be named after its own scheduled time.
+			expectedNames := []string{}
+			for t := lastScheduleTime.Truncate(time.Minute).Add(time.Minute); !t.After(time.Now()); t = t.Add(time.Minute) {
+				expectedNames = append(expectedNames, getDefaultJobName(cron, t))
+			}
+			Expect(expectedNames).To(HaveLen(3))
+
+			_, err := r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			uList := &unstructured.UnstructuredList{}
+			uList.SetGroupVersionKind(kubeflowv1.SchemeGroupVersion.WithKind("PyTorchJob"))
+			Eventually(func() []string {
+				_ = k8sClient.List(ctx, uList, client.InNamespace(namespace), client.MatchingLabels{common.LabelCronName: name})
+				names := []string{}
+				for _, item := range uList.Items {
+					names = append(names, item.GetName())
+				}
+				return names
+			}, time.Second*5, time.Millisecond*500).Should(ContainElements(expectedNames))
+		})
+
+		It("should stop scheduling once the maximum number of runs is reached", func() {
+			recorder := record.NewFakeRecorder(10)
+			r := NewCronReconciler(scheme, k8sClient, k8sClient, recorder, nil, nil)
+
+			cron := &v1alpha1.Cron{}
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			cron.Spec.ConcurrencyPolicy = v1alpha1.ConcurrentPolicyAllow
+			cron.Spec.CatchUpPolicy = v1alpha1.CatchUpPolicyAll
+			cron.Spec.MaxRuns = ptr.To[int32](3)
+			Expect(k8sClient.Update(ctx, cron)).To(Succeed())
+			lastScheduleTime := time.Now().Add(-3 * time.Minute)
+			cron.Status.LastScheduleTime = &metav1.Time{Time: lastScheduleTime}
+			cron.Status.RunCount = 1
+			Expect(k8sClient.Status().Update(ctx, cron)).To(Succeed())
+
+			// Only the two oldest missed runs are left to reach the maximum number of runs.
+			firstRun := lastScheduleTime.Truncate(time.Minute).Add(time.Minute)
+			expectedNames := []string{getDefaultJobName(cron, firstRun), getDefaultJobName(cron, firstRun.Add(time.Minute))}
+
+			result, err := r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeZero())
+
+			uList := &unstructured.UnstructuredList{}
+			uList.SetGroupVersionKind(kubeflowv1.SchemeGroupVersion.WithKind("PyTorchJob"))
+			Expect(k8sClient.List(ctx, uList, client.InNamespace(namespace), client.MatchingLabels{common.LabelCronName: name})).To(Succeed())
+			names := []string{}
+			for _, item := range uList.Items {
+				names = append(names, item.GetName())
+			}
+			Expect(names).To(ConsistOf(expectedNames))
+
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			Expect(cron.Status.RunCount).To(BeEquivalentTo(3))
+			Expect(meta.IsStatusConditionTrue(cron.Status.Conditions, v1alpha1.CronConditionComplete)).To(BeTrue())
+			Expect(meta.IsStatusConditionFalse(cron.Status.Conditions, v1alpha1.CronConditionScheduling)).To(BeTrue())
+			Expect(recorder.Events).To(Receive(ContainSubstring("MaxRunsReached")))
+
+			// No more runs are created, and the event is not emitted again.
+			_, err = r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.List(ctx, uList, client.InNamespace(namespace), client.MatchingLabels{common.LabelCronName: name})).To(Succeed())
+			Expect(uList.Items).To(HaveLen(2))
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("should bound the number of active runs", func() {
+			r := NewCronReconciler(scheme, k8sClient, k8sClient, record.NewFakeRecorder(10), nil, nil)
+
+			cron := &v1alpha1.Cron{}
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			cron.Spec.ConcurrencyPolicy = v1alpha1.ConcurrentPolicyAllow
+			cron.Spec.CatchUpPolicy = v1alpha1.CatchUpPolicyAll
+			cron.Spec.MaxActiveRuns = ptr.To[int32](2)
+			Expect(k8sClient.Update(ctx, cron)).To(Succeed())
+			lastScheduleTime := time.Now().Add(-3 * time.Minute)
+			cron.Status.LastScheduleTime = &metav1.Time{Time: lastScheduleTime}
+			Expect(k8sClient.Status().Update(ctx, cron)).To(Succeed())
+
+			listNames := func() []string {
+				uList := &unstructured.UnstructuredList{}
+				uList.SetGroupVersionKind(kubeflowv1.SchemeGroupVersion.WithKind("PyTorchJob"))
+				Expect(k8sClient.List(ctx, uList, client.InNamespace(namespace), client.MatchingLabels{common.LabelCronName: name})).To(Succeed())
+				names := []string{}
+				for _, item := range uList.Items {
+					names = append(names, item.GetName())
+				}
+				return names
+			}
+
+			// The two oldest missed runs are created, and the last one is skipped.
+			firstRun := lastScheduleTime.Truncate(time.Minute).Add(time.Minute)
+			_, err := r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(listNames()).To(ConsistOf(getDefaultJobName(cron, firstRun), getDefaultJobName(cron, firstRun.Add(time.Minute))))
+
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			Expect(cron.Status.SkippedRuns).To(ContainElement(HaveField("Reason", v1alpha1.SkipReasonMaxActiveRunsReached)))
+			Expect(cron.Status.LastScheduleTime.Time).To(BeTemporally("==", firstRun.Add(2*time.Minute)))
+
+			// A triggered run replaces the oldest active run.
+			cron.Spec.MaxActiveRunsPolicy = v1alpha1.MaxActiveRunsPolicyReplaceOldest
+			cron.Annotations = map[string]string{common.AnnotationTrigger: "token-1"}
+			Expect(k8sClient.Update(ctx, cron)).To(Succeed())
+			_, err = r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(listNames()).To(ConsistOf(getDefaultJobName(cron, firstRun.Add(time.Minute)), getTriggeredJobName(cron, "token-1")))
+		})
+
+		It("should queue runs while a run is active with concurrency policy Queue", func() {
+			r := NewCronReconciler(scheme, k8sClient, k8sClient, record.NewFakeRecorder(10), nil, nil)
+
+			cron := &v1alpha1.Cron{}
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			cron.Spec.ConcurrencyPolicy = v1alpha1.ConcurrentPolicyQueue
+			cron.Spec.CatchUpPolicy = v1alpha1.CatchUpPolicyAll
+			cron.Spec.MaxQueuedRuns = ptr.To[int32](1)
+			Expect(k8sClient.Update(ctx, cron)).To(Succeed())
+			lastScheduleTime := time.Now().Add(-3 * time.Minute)
+			cron.Status.LastScheduleTime = &metav1.Time{Time: lastScheduleTime}
+			Expect(k8sClient.Status().Update(ctx, cron)).To(Succeed())
+
+			// The oldest missed run is created, the next one is queued and the last one is skipped.
+			firstRun := lastScheduleTime.Truncate(time.Minute).Add(time.Minute)
+			_, err := r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			workload := &unstructured.Unstructured{}
+			workload.SetGroupVersionKind(kubeflowv1.SchemeGroupVersion.WithKind("PyTorchJob"))
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: getDefaultJobName(cron, firstRun)}, workload)).To(Succeed())
+
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			Expect(cron.Status.QueuedRuns).To(HaveExactElements(HaveField("ScheduleTime.Time", BeTemporally("==", firstRun.Add(time.Minute)))))
+			Expect(cron.Status.SkippedRuns).To(ContainElement(HaveField("Reason", v1alpha1.SkipReasonQueueFull)))
+			Expect(cron.Status.LastScheduleTime.Time).To(BeTemporally("==", firstRun.Add(2*time.Minute)))
+
+			// The queued run is created once the active run has finished.
+			workload.Object["status"] = map[string]interface{}{
+				"conditions":      []interface{}{map[string]interface{}{"type": string(kubeflowv1.JobSucceeded), "status": string(corev1.ConditionTrue)}},
+				"replicaStatuses": map[string]interface{}{},
+			}
+			Expect(k8sClient.Status().Update(ctx, workload)).To(Succeed())
+			_, err = r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: getDefaultJobName(cron, firstRun.Add(time.Minute))}, workload)).To(Succeed())
+
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			Expect(cron.Status.QueuedRuns).To(BeEmpty())
+			Expect(cron.Status.RunCount).To(BeEquivalentTo(2))
+		})
+
+		It("should wait for replaced runs to terminate with concurrency policy Replace", func() {
+			recorder := record.NewFakeRecorder(10)
+			r := NewCronReconciler(scheme, k8sClient, k8sClient, recorder, nil, nil)
+
+			cron := &v1alpha1.Cron{}
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			cron.Spec.ConcurrencyPolicy = v1alpha1.ConcurrentPolicyReplace
+			Expect(k8sClient.Update(ctx, cron)).To(Succeed())
+			cron.Status.LastScheduleTime = &metav1.Time{Time: time.Now().Add(-90 * time.Second)}
+			Expect(k8sClient.Status().Update(ctx, cron)).To(Succeed())
+
+			_, err := r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			uList := &unstructured.UnstructuredList{}
+			uList.SetGroupVersionKind(kubeflowv1.SchemeGroupVersion.WithKind("PyTorchJob"))
+			Expect(k8sClient.List(ctx, uList, client.InNamespace(namespace), client.MatchingLabels{common.LabelCronName: name})).To(Succeed())
+			Expect(uList.Items).To(HaveLen(1))
+			old := uList.Items[0]
+
+			// A pod of the old run is owned through an intermediate Job.
+			job := &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{Name: old.GetName() + "-launcher", Namespace: namespace},
+				Spec: batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							RestartPolicy: corev1.RestartPolicyNever,
+							Containers:    []corev1.Container{{Name: "pytorch", Image: "pytorch"}},
+						},
+					},
+				},
+			}
+			Expect(controllerutil.SetControllerReference(&old, job, scheme)).To(Succeed())
+			Expect(k8sClient.Create(ctx, job)).To(Succeed())
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: old.GetName() + "-launcher-0", Namespace: namespace, Labels: map[string]string{common.LabelCronName: name}},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "pytorch", Image: "pytorch"}}},
+			}
+			Expect(controllerutil.SetControllerReference(job, pod, scheme)).To(Succeed())
+			Expect(k8sClient.Create(ctx, pod)).To(Succeed())
+
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			cron.Spec.ReplacePolicy = &v1alpha1.CronReplacePolicy{GracePeriodSeconds: ptr.To[int64](0)}
+			Expect(k8sClient.Update(ctx, cron)).To(Succeed())
+			cron.Status.LastScheduleTime = &metav1.Time{Time: time.Now().Add(-90 * time.Second)}
+			Expect(k8sClient.Status().Update(ctx, cron)).To(Succeed())
+
+			// The old run is deleted in the foreground along with the pods it owns through its Job.
+			result, err := r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(replacePollInterval))
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(Satisfy(apierrors.IsNotFound))
+			Expect(k8sClient.List(ctx, uList, client.InNamespace(namespace), client.MatchingLabels{common.LabelCronName: name})).To(Succeed())
+			Expect(uList.Items).To(HaveExactElements(HaveField("Object", HaveKeyWithValue("metadata", HaveKeyWithValue("finalizers", ContainElement(metav1.FinalizerDeleteDependents))))))
+
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			Expect(cron.Status.Replacing).To(HaveExactElements(HaveField("UID", old.GetUID())))
+			Expect(meta.IsStatusConditionTrue(cron.Status.Conditions, v1alpha1.CronConditionReplacing)).To(BeTrue())
+			Expect(recorder.Events).To(Receive(ContainSubstring("Replacing")))
+
+			// The new run is created once the old run is gone, which the garbage collector does not do in tests.
+			terminating := uList.Items[0]
+			terminating.SetFinalizers(nil)
+			Expect(k8sClient.Update(ctx, &terminating)).To(Succeed())
+			_, err = r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.List(ctx, uList, client.InNamespace(namespace), client.MatchingLabels{common.LabelCronName: name})).To(Succeed())
+			Expect(uList.Items).To(HaveExactElements(HaveField("Object", HaveKeyWithValue("metadata", Not(HaveKey("deletionTimestamp"))))))
+
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			Expect(cron.Status.Replacing).To(BeEmpty())
+			Expect(meta.FindStatusCondition(cron.Status.Conditions, v1alpha1.CronConditionReplacing)).To(BeNil())
+			Expect(k8sClient.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground))).To(Succeed())
+		})
+
+		It("should limit the runs of the Crons in the same concurrency group", func() {
+			r := NewCronReconciler(scheme, k8sClient, k8sClient, record.NewFakeRecorder(10), nil, nil)
+
+			// Another Cron of the group holds it with an active run.
+			holder := &v1alpha1.Cron{
+				ObjectMeta: metav1.ObjectMeta{Name: "cron-holder", Namespace: namespace},
+				Spec: v1alpha1.CronSpec{
+					Schedule:         "0 0 1 1 *",
+					ConcurrencyGroup: "shard-1",
+					Template: v1alpha1.CronTemplateSpec{
+						Workload: &runtime.RawExtension{
+							Raw: []byte(`{"apiVersion":"kubeflow.org/v1","kind":"PyTorchJob"}`),
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, holder)).To(Succeed())
+			defer func() {
+				Expect(k8sClient.Delete(ctx, holder)).To(Succeed())
+			}()
+			holderRun := &unstructured.Unstructured{}
+			holderRun.SetGroupVersionKind(kubeflowv1.SchemeGroupVersion.WithKind("PyTorchJob"))
+			holderRun.SetNamespace(namespace)
+			holderRun.SetName("cron-holder-1")
+			holderRun.SetLabels(map[string]string{common.LabelCronName: holder.Name})
+			Expect(k8sClient.Create(ctx, holderRun)).To(Succeed())
+			defer func() {
+				Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, holderRun))).To(Succeed())
+			}()
+
+			cron := &v1alpha1.Cron{}
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			cron.Spec.ConcurrencyGroup = "shard-1"
+			Expect(k8sClient.Update(ctx, cron)).To(Succeed())
+			lastScheduleTime := time.Now().Add(-90 * time.Second).Truncate(time.Second)
+			cron.Status.LastScheduleTime = &metav1.Time{Time: lastScheduleTime}
+			Expect(k8sClient.Status().Update(ctx, cron)).To(Succeed())
+
+			// The run waits while the group is full.
+			_, err := r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			uList := &unstructured.UnstructuredList{}
+			uList.SetGroupVersionKind(kubeflowv1.SchemeGroupVersion.WithKind("PyTorchJob"))
+			Expect(k8sClient.List(ctx, uList, client.InNamespace(namespace), client.MatchingLabels{common.LabelCronName: name})).To(Succeed())
+			Expect(uList.Items).To(BeEmpty())
+
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			Expect(cron.Status.ConcurrencyGroupHolders).To(HaveExactElements("cron-holder"))
+			Expect(cron.Status.LastScheduleTime.Time).To(BeTemporally("==", lastScheduleTime))
+
+			// The run is created once the run of the holder has finished.
+			holderRun.Object["status"] = map[string]interface{}{
+				"conditions":      []interface{}{map[string]interface{}{"type": string(kubeflowv1.JobSucceeded), "status": string(corev1.ConditionTrue)}},
+				"replicaStatuses": map[string]interface{}{},
+			}
+			Expect(k8sClient.Status().Update(ctx, holderRun)).To(Succeed())
+			_, err = r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.List(ctx, uList, client.InNamespace(namespace), client.MatchingLabels{common.LabelCronName: name})).To(Succeed())
+			Expect(uList.Items).To(HaveLen(1))
+
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			Expect(cron.Status.ConcurrencyGroupHolders).To(BeEmpty())
+		})
+
+		It("should not exceed the limit of the concurrency group with the stale status of another Cron", func() {
+			r := NewCronReconciler(scheme, k8sClient, k8sClient, record.NewFakeRecorder(10), nil, nil)
+
+			// Another Cron of the group fires at the same time.
+			other := &v1alpha1.Cron{
+				ObjectMeta: metav1.ObjectMeta{Name: "cron-other", Namespace: namespace},
+				Spec: v1alpha1.CronSpec{
+					Schedule:         "*/1 * * * *",
+					ConcurrencyGroup: "shard-1",
+					Template: v1alpha1.CronTemplateSpec{
+						Workload: &runtime.RawExtension{
+							Raw: []byte(`{"apiVersion":"kubeflow.org/v1","kind":"PyTorchJob"}`),
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, other)).To(Succeed())
+			defer func() {
+				Expect(k8sClient.Delete(ctx, other)).To(Succeed())
+				workload := &unstructured.Unstructured{}
+				workload.SetGroupVersionKind(kubeflowv1.SchemeGroupVersion.WithKind("PyTorchJob"))
+				Expect(k8sClient.DeleteAllOf(ctx, workload, client.InNamespace(namespace), client.MatchingLabels{common.LabelCronName: other.Name})).To(Succeed())
+			}()
+			lastScheduleTime := time.Now().Add(-90 * time.Second)
+			other.Status.LastScheduleTime = &metav1.Time{Time: lastScheduleTime}
+			Expect(k8sClient.Status().Update(ctx, other)).To(Succeed())
+
+			cron := &v1alpha1.Cron{}
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			cron.Spec.ConcurrencyGroup = "shard-1"
+			Expect(k8sClient.Update(ctx, cron)).To(Succeed())
+			cron.Status.LastScheduleTime = &metav1.Time{Time: lastScheduleTime}
+			Expect(k8sClient.Status().Update(ctx, cron)).To(Succeed())
+
+			_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(other)})
+			Expect(err).NotTo(HaveOccurred())
+
+			// The status of the other Cron does not show its active run yet.
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(other), other)).To(Succeed())
+			other.Status.Active = nil
+			Expect(k8sClient.Status().Update(ctx, other)).To(Succeed())
+
+			_, err = r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			uList := &unstructured.UnstructuredList{}
+			uList.SetGroupVersionKind(kubeflowv1.SchemeGroupVersion.WithKind("PyTorchJob"))
+			Expect(k8sClient.List(ctx, uList, client.InNamespace(namespace), client.MatchingLabels{common.LabelCronName: name})).To(Succeed())
+			Expect(uList.Items).To(BeEmpty())
+
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			Expect(cron.Status.ConcurrencyGroupHolders).To(HaveExactElements("cron-other"))
+		})
+
+		It("should requeue runs throttled by the rate limit", func() {
+			limiter := ratelimit.New(1, 1, 1)
+			r := NewCronReconciler(scheme, k8sClient, k8sClient, record.NewFakeRecorder(10), limiter, nil)
+
+			cron := &v1alpha1.Cron{}
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			lastScheduleTime := time.Now().Add(-90 * time.Second).Truncate(time.Second)
+			cron.Status.LastScheduleTime = &metav1.Time{Time: lastScheduleTime}
+			Expect(k8sClient.Status().Update(ctx, cron)).To(Succeed())
+
+			// The missed run is held back while the operator has no tokens left.
+			_, throttled := limiter.Reserve(namespace)
+			Expect(throttled).To(BeZero())
+			result, err := r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically("~", time.Second, 100*time.Millisecond))
+			uList := &unstructured.UnstructuredList{}
+			uList.SetGroupVersionKind(kubeflowv1.SchemeGroupVersion.WithKind("PyTorchJob"))
+			Expect(k8sClient.List(ctx, uList, client.InNamespace(namespace), client.MatchingLabels{common.LabelCronName: name})).To(Succeed())
+			Expect(uList.Items).To(BeEmpty())
+
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			Expect(cron.Status.LastScheduleTime.Time).To(BeTemporally("==", lastScheduleTime))
+
+			// The missed run is created once the limit allows.
+			time.Sleep(result.RequeueAfter)
+			_, err = r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.List(ctx, uList, client.InNamespace(namespace), client.MatchingLabels{common.LabelCronName: name})).To(Succeed())
+			Expect(uList.Items).To(HaveLen(1))
+		})
+
+		It("should record a batch/v1 Job as succeeded once it has completed", func() {
+			r := NewCronReconciler(scheme, k8sClient, k8sClient, record.NewFakeRecorder(10), nil, nil)
+
+			cron := &v1alpha1.Cron{}
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			cron.Spec.Template.Workload = &runtime.RawExtension{
+				Raw: []byte(`{"apiVersion":"batch/v1","kind":"Job","spec":{"template":{"spec":{"restartPolicy":"Never","containers":[{"name":"prep","image":"busybox"}]}}}}`),
+			}
+			Expect(k8sClient.Update(ctx, cron)).To(Succeed())
+			cron.Status.LastScheduleTime = &metav1.Time{Time: time.Now().Add(-90 * time.Second)}
+			Expect(k8sClient.Status().Update(ctx, cron)).To(Succeed())
+
+			_, err := r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			jobs := &batchv1.JobList{}
+			Expect(k8sClient.List(ctx, jobs, client.InNamespace(namespace), client.MatchingLabels{common.LabelCronName: name})).To(Succeed())
+			Expect(jobs.Items).To(HaveLen(1))
+			job := &jobs.Items[0]
+			defer func() {
+				Expect(k8sClient.DeleteAllOf(ctx, &batchv1.Job{}, client.InNamespace(namespace), client.PropagationPolicy(metav1.DeletePropagationBackground))).To(Succeed())
+			}()
+
+			// Complete the Job, as there is no Job controller in envtest.
+			now := metav1.Now()
+			job.Status = batchv1.JobStatus{
+				StartTime:      &now,
+				CompletionTime: &now,
+				Succeeded:      1,
+				Conditions: []batchv1.JobCondition{
+					{Type: batchv1.JobSuccessCriteriaMet, Status: corev1.ConditionTrue, LastTransitionTime: now},
+					{Type: batchv1.JobComplete, Status: corev1.ConditionTrue, LastTransitionTime: now},
+				},
+			}
+			Expect(k8sClient.Status().Update(ctx, job)).To(Succeed())
+
+			_, err = r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			Expect(cron.Status.Active).To(BeEmpty())
+			Expect(cron.Status.History).To(ContainElement(And(
+				HaveField("Object.Name", job.Name),
+				HaveField("Status", kubeflowv1.JobSucceeded),
+			)))
+		})
+
+		It("should interpret the status of workloads with the status rules of the Cron", func() {
+			// The status rules of the Cron override the ones of the operator configuration.
+			statusRules, err := statusrule.NewSet(statusrule.Config{Rules: []statusrule.KindRules{{
+				APIVersion:          "batch/v1",
+				Kind:                "Job",
+				WorkloadStatusRules: v1alpha1.WorkloadStatusRules{Succeeded: "false", Failed: "false"},
+			}}})
+			Expect(err).NotTo(HaveOccurred())
+			r := NewCronReconciler(scheme, k8sClient, k8sClient, record.NewFakeRecorder(10), nil, statusRules)
+
+			cron := &v1alpha1.Cron{}
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			cron.Spec.Template.Workload = &runtime.RawExtension{
+				Raw: []byte(`{"apiVersion":"batch/v1","kind":"Job","spec":{"template":{"spec":{"restartPolicy":"Never","containers":[{"name":"prep","image":"busybox"}]}}}}`),
+			}
+			cron.Spec.Template.StatusRules = &v1alpha1.WorkloadStatusRules{
+				Succeeded: "has(self.status.succeeded) && self.status.succeeded > 0",
+				Failed:    "has(self.status.failed) && self.status.failed > 0",
+			}
+			Expect(k8sClient.Update(ctx, cron)).To(Succeed())
+			cron.Status.LastScheduleTime = &metav1.Time{Time: time.Now().Add(-90 * time.Second)}
+			Expect(k8sClient.Status().Update(ctx, cron)).To(Succeed())
+
+			_, err = r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			jobs := &batchv1.JobList{}
+			Expect(k8sClient.List(ctx, jobs, client.InNamespace(namespace), client.MatchingLabels{common.LabelCronName: name})).To(Succeed())
+			Expect(jobs.Items).To(HaveLen(1))
+			job := &jobs.Items[0]
+			defer func() {
+				Expect(k8sClient.DeleteAllOf(ctx, &batchv1.Job{}, client.InNamespace(namespace), client.PropagationPolicy(metav1.DeletePropagationBackground))).To(Succeed())
+			}()
+
+			// A succeeded pod is enough for the status rules, while the Job has no Complete condition yet.
+			now := metav1.Now()
+			job.Status = batchv1.JobStatus{StartTime: &now, Succeeded: 1}
+			Expect(k8sClient.Status().Update(ctx, job)).To(Succeed())
+
+			_, err = r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			Expect(cron.Status.Active).To(BeEmpty())
+			Expect(cron.Status.History).To(ContainElement(And(
+				HaveField("Object.Name", job.Name),
+				HaveField("Status", kubeflowv1.JobSucceeded),
+			)))
+		})
+
+		It("should consider workloads whose status cannot be evaluated as active", func() {
+			recorder := record.NewFakeRecorder(10)
+			r := NewCronReconciler(scheme, k8sClient, k8sClient, recorder, nil, nil)
+
+			cron := &v1alpha1.Cron{}
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			cron.Spec.ConcurrencyPolicy = v1alpha1.ConcurrentPolicyForbid
+			cron.Spec.Template.Workload = &runtime.RawExtension{
+				Raw: []byte(`{"apiVersion":"batch/v1","kind":"Job","spec":{"template":{"spec":{"restartPolicy":"Never","containers":[{"name":"prep","image":"busybox"}]}}}}`),
+			}
+			// The rules fail to evaluate as long as the Job reports no pod counts.
+			cron.Spec.Template.StatusRules = &v1alpha1.WorkloadStatusRules{
+				Succeeded: "self.status.succeeded > 0",
+				Failed:    "self.status.failed > 0",
+			}
+			Expect(k8sClient.Update(ctx, cron)).To(Succeed())
+			cron.Status.LastScheduleTime = &metav1.Time{Time: time.Now().Add(-90 * time.Second)}
+			Expect(k8sClient.Status().Update(ctx, cron)).To(Succeed())
+			defer func() {
+				Expect(k8sClient.DeleteAllOf(ctx, &batchv1.Job{}, client.InNamespace(namespace), client.PropagationPolicy(metav1.DeletePropagationBackground))).To(Succeed())
+			}()
+
+			_, err := r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			jobs := &batchv1.JobList{}
+			Expect(k8sClient.List(ctx, jobs, client.InNamespace(namespace), client.MatchingLabels{common.LabelCronName: name})).To(Succeed())
+			Expect(jobs.Items).To(HaveLen(1))
+
+			// The next run is due, but the concurrency policy forbids it while the first one is considered active.
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			cron.Status.LastScheduleTime = &metav1.Time{Time: time.Now().Add(-90 * time.Second)}
+			Expect(k8sClient.Status().Update(ctx, cron)).To(Succeed())
+			_, err = r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			Expect(cron.Status.Active).To(ConsistOf(HaveField("Name", jobs.Items[0].Name)))
+			Expect(k8sClient.List(ctx, jobs, client.InNamespace(namespace), client.MatchingLabels{common.LabelCronName: name})).To(Succeed())
+			Expect(jobs.Items).To(HaveLen(1))
+			Eventually(recorder.Events).Should(Receive(ContainSubstring("FailedGetStatus")))
+		})
+
+		It("should wait for the CRD of the workload kind to be installed", func() {
+			recorder := record.NewFakeRecorder(10)
+			r := NewCronReconciler(scheme, k8sClient, k8sClient, recorder, nil, nil)
+
+			cron := &v1alpha1.Cron{}
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			cron.Spec.Template.Workload = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"example.com/v1","kind":"Training"}`)}
+			Expect(k8sClient.Update(ctx, cron)).To(Succeed())
+			cron.Status.LastScheduleTime = &metav1.Time{Time: time.Now().Add(-90 * time.Second)}
+			Expect(k8sClient.Status().Update(ctx, cron)).To(Succeed())
+
+			result, err := r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(minWorkloadKindBackoff))
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			condition := meta.FindStatusCondition(cron.Status.Conditions, v1alpha1.CronConditionWorkloadKindAvailable)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(v1alpha1.CronReasonWorkloadKindNotFound))
+			Expect(condition.Message).To(Equal("the CRD of Training in example.com/v1 is not installed"))
+			Expect(cron.Status.LastScheduleTime.Time).To(BeTemporally("<", time.Now().Add(-time.Minute)))
+			Expect(recorder.Events).To(Receive(ContainSubstring(v1alpha1.CronReasonWorkloadKindNotFound)))
+
+			// The event is only emitted once the kind becomes unavailable.
+			_, err = r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recorder.Events).NotTo(Receive())
+
+			// Install the CRD, which is discovered by the next reconciliation.
+			crd := &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "apiextensions.k8s.io/v1",
+				"kind":       "CustomResourceDefinition",
+				"metadata":   map[string]interface{}{"name": "trainings.example.com"},
+				"spec": map[string]interface{}{
+					"group": "example.com",
+					"names": map[string]interface{}{"kind": "Training", "listKind": "TrainingList", "plural": "trainings", "singular": "training"},
+					"scope": "Namespaced",
+					"versions": []interface{}{map[string]interface{}{
+						"name":    "v1",
+						"served":  true,
+						"storage": true,
+						"schema": map[string]interface{}{"openAPIV3Schema": map[string]interface{}{
+							"type":                                 "object",
+							"x-kubernetes-preserve-unknown-fields": true,
+						}},
+					}},
+				},
+			}}
+			Expect(k8sClient.Create(ctx, crd)).To(Succeed())
+			defer func() {
+				Expect(k8sClient.Delete(ctx, crd)).To(Succeed())
+			}()
+
+			Eventually(func(g Gomega) {
+				_, err := r.Reconcile(ctx, req)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+				g.Expect(meta.IsStatusConditionTrue(cron.Status.Conditions, v1alpha1.CronConditionWorkloadKindAvailable)).To(BeTrue())
+			}, 10*time.Second, 100*time.Millisecond).Should(Succeed())
+			Expect(cron.Status.Active).To(HaveLen(1))
+			Expect(recorder.Events).To(Receive(ContainSubstring(v1alpha1.CronReasonWorkloadKindFound)))
+		})
+
+		It("should wait for missing calendars to be created", func() {
+			recorder := record.NewFakeRecorder(10)
+			r := NewCronReconciler(scheme, k8sClient, k8sClient, recorder, nil, nil)
+
+			cron := &v1alpha1.Cron{}
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			cron.Spec.CalendarRefs = []corev1.LocalObjectReference{{Name: "maintenance"}}
+			Expect(k8sClient.Update(ctx, cron)).To(Succeed())
+			cron.Status.LastScheduleTime = &metav1.Time{Time: time.Now().Add(-90 * time.Second)}
+			Expect(k8sClient.Status().Update(ctx, cron)).To(Succeed())
+
+			result, err := r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(minCalendarBackoff))
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			condition := meta.FindStatusCondition(cron.Status.Conditions, v1alpha1.CronConditionCalendarAvailable)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(v1alpha1.CronReasonCalendarNotFound))
+			Expect(condition.Message).To(ContainSubstring("calendar maintenance"))
+			Expect(cron.Status.LastScheduleTime.Time).To(BeTemporally("<", time.Now().Add(-time.Minute)))
+			Expect(recorder.Events).To(Receive(ContainSubstring(v1alpha1.CronReasonCalendarNotFound)))
+
+			// The event is only emitted once the calendar becomes unavailable.
+			_, err = r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recorder.Events).NotTo(Receive())
+
+			cronCalendar := &v1alpha1.CronCalendar{
+				ObjectMeta: metav1.ObjectMeta{Name: "maintenance", Namespace: namespace},
+				Spec:       v1alpha1.CronCalendarSpec{ExcludedDates: []v1alpha1.CronCalendarDate{{Date: "2000-01-01"}}},
+			}
+			Expect(k8sClient.Create(ctx, cronCalendar)).To(Succeed())
+			defer func() {
+				Expect(k8sClient.Delete(ctx, cronCalendar)).To(Succeed())
+			}()
+
+			_, err = r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(cron.Status.Conditions, v1alpha1.CronConditionCalendarAvailable)).To(BeTrue())
+			uList := &unstructured.UnstructuredList{}
+			uList.SetGroupVersionKind(kubeflowv1.SchemeGroupVersion.WithKind("PyTorchJob"))
+			Expect(k8sClient.List(ctx, uList, client.InNamespace(namespace), client.MatchingLabels{common.LabelCronName: name})).To(Succeed())
+			Expect(uList.Items).To(HaveLen(1))
+			Expect(recorder.Events).To(Receive(ContainSubstring(v1alpha1.CronReasonCalendarFound)))
+		})
+
+		It("should create a workload for each schedule firing at the same time", func() {
+			r := NewCronReconciler(scheme, k8sClient, k8sClient, record.NewFakeRecorder(10), nil, nil)
+
+			cron := &v1alpha1.Cron{}
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			cron.Spec.Schedule = ""
+			cron.Spec.Schedules = []v1alpha1.CronSchedule{
+				{Name: "a", Schedule: "*/1 * * * *"},
+				{Name: "b", Schedule: "*/1 * * * *"},
+			}
+			Expect(k8sClient.Update(ctx, cron)).To(Succeed())
+			lastScheduleTime := metav1.NewTime(time.Now().Add(-2 * time.Minute))
+			cron.Status.Schedules = []v1alpha1.CronScheduleStatus{
+				{Name: "a", LastScheduleTime: &lastScheduleTime},
+				{Name: "b", LastScheduleTime: &lastScheduleTime},
+			}
+			Expect(k8sClient.Status().Update(ctx, cron)).To(Succeed())
+
+			_, err := r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			// The concurrency policy forbid should not prevent the other schedule from running.
+			uList := &unstructured.UnstructuredList{}
+			uList.SetGroupVersionKind(kubeflowv1.SchemeGroupVersion.WithKind("PyTorchJob"))
+			Eventually(func() []string {
+				_ = k8sClient.List(ctx, uList, client.InNamespace(namespace), client.MatchingLabels{common.LabelCronName: name})
+				schedules := []string{}
+				for _, item := range uList.Items {
+					schedules = append(schedules, item.GetLabels()[common.LabelCronSchedule])
+				}
+				return schedules
+			}, time.Second*5, time.Millisecond*500).Should(ConsistOf("a", "b"))
+
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			Expect(cron.Status.Schedules).To(HaveLen(2))
+			Expect(cron.Status.Schedules[0].LastScheduleTime.After(lastScheduleTime.Time)).To(BeTrue())
+			Expect(cron.Status.Schedules[1].LastScheduleTime.After(lastScheduleTime.Time)).To(BeTrue())
+		})
+
+		It("should not create a workload if suspended", func() {
+			r := NewCronReconciler(scheme, k8sClient, k8sClient, nil, nil, nil)
+
+			cron := &v1alpha1.Cron{}
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			cron.Spec.Suspend = ptr.To(true)
+			Expect(k8sClient.Update(ctx, cron)).To(Succeed())
+
+			_, err := r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Check that no PyTorchJob was created
+			uList := &unstructured.UnstructuredList{}
+			uList.SetGroupVersionKind(kubeflowv1.SchemeGroupVersion.WithKind("PyTorchJob"))
+			Consistently(func() int {
+				_ = k8sClient.List(ctx, uList, client.InNamespace(namespace))
+				return len(uList.Items)
+			}, time.Second*2, time.Millisecond*500).Should(Equal(0))
+		})
+
+		It("should create one run for each trigger token", func() {
+			r := NewCronReconciler(scheme, k8sClient, k8sClient, record.NewFakeRecorder(10), nil, nil)
+
+			cron := &v1alpha1.Cron{}
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			cron.Annotations = map[string]string{common.AnnotationTrigger: "token-1"}
+			Expect(k8sClient.Update(ctx, cron)).To(Succeed())
+
+			_, err := r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			workload := &unstructured.Unstructured{}
+			workload.SetGroupVersionKind(kubeflowv1.SchemeGroupVersion.WithKind("PyTorchJob"))
+			workloadKey := types.NamespacedName{Namespace: namespace, Name: getTriggeredJobName(cron, "token-1")}
+			Expect(k8sClient.Get(ctx, workloadKey, workload)).To(Succeed())
+			Expect(workload.GetLabels()).To(HaveKeyWithValue(common.LabelCronName, name))
+			Expect(workload.GetAnnotations()).To(HaveKeyWithValue(common.AnnotationCronTrigger, "token-1"))
+			Expect(metav1.IsControlledBy(workload, cron)).To(BeTrue())
+
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			Expect(cron.Status.LastTriggerToken).To(Equal("token-1"))
+
+			// A handled token does not trigger another run, and a new token is forbidden
+			// by the concurrency policy while the triggered run is active.
+			_, err = r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			cron.Annotations[common.AnnotationTrigger] = "token-2"
+			Expect(k8sClient.Update(ctx, cron)).To(Succeed())
+			_, err = r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			Expect(cron.Status.LastTriggerToken).To(Equal("token-2"))
+			Expect(cron.Status.SkippedRuns).To(ContainElement(HaveField("Reason", v1alpha1.SkipReasonConcurrencyForbidden)))
+			workloadKey.Name = getTriggeredJobName(cron, "token-2")
+			Expect(apierrors.IsNotFound(k8sClient.Get(ctx, workloadKey, workload))).To(BeTrue())
+		})
+
+		It("should count triggered runs against the maximum number of runs", func() {
+			r := NewCronReconciler(scheme, k8sClient, k8sClient, record.NewFakeRecorder(10), nil, nil)
+
+			cron := &v1alpha1.Cron{}
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			cron.Annotations = map[string]string{common.AnnotationTrigger: "token-1"}
+			cron.Spec.ConcurrencyPolicy = v1alpha1.ConcurrentPolicyAllow
+			cron.Spec.MaxRuns = ptr.To[int32](1)
+			Expect(k8sClient.Update(ctx, cron)).To(Succeed())
+
+			_, err := r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			Expect(cron.Status.RunCount).To(BeEquivalentTo(1))
+			Expect(meta.IsStatusConditionTrue(cron.Status.Conditions, v1alpha1.CronConditionComplete)).To(BeTrue())
+
+			// Another token is skipped once the maximum has been reached.
+			cron.Annotations[common.AnnotationTrigger] = "token-2"
+			Expect(k8sClient.Update(ctx, cron)).To(Succeed())
+			_, err = r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			workload := &unstructured.Unstructured{}
+			workload.SetGroupVersionKind(kubeflowv1.SchemeGroupVersion.WithKind("PyTorchJob"))
+			workloadKey := types.NamespacedName{Namespace: namespace, Name: getTriggeredJobName(cron, "token-2")}
+			Expect(apierrors.IsNotFound(k8sClient.Get(ctx, workloadKey, workload))).To(BeTrue())
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			Expect(cron.Status.LastTriggerToken).To(Equal("token-2"))
+			Expect(cron.Status.RunCount).To(BeEquivalentTo(1))
+			Expect(cron.Status.SkippedRuns).To(ContainElement(HaveField("Reason", v1alpha1.SkipReasonMaxRunsReached)))
+		})
+
+		It("should skip runs triggered while suspended", func() {
+			r := NewCronReconciler(scheme, k8sClient, k8sClient, record.NewFakeRecorder(10), nil, nil)
+
+			cron := &v1alpha1.Cron{}
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			cron.Annotations = map[string]string{common.AnnotationTrigger: "token-1"}
+			cron.Spec.Suspend = ptr.To(true)
+			Expect(k8sClient.Update(ctx, cron)).To(Succeed())
+
+			_, err := r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			workload := &unstructured.Unstructured{}
+			workload.SetGroupVersionKind(kubeflowv1.SchemeGroupVersion.WithKind("PyTorchJob"))
+			workloadKey := types.NamespacedName{Namespace: namespace, Name: getTriggeredJobName(cron, "token-1")}
+			Expect(apierrors.IsNotFound(k8sClient.Get(ctx, workloadKey, workload))).To(BeTrue())
+
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			Expect(cron.Status.LastTriggerToken).To(Equal("token-1"))
+			Expect(cron.Status.SkippedRuns).To(ContainElement(HaveField("Reason", v1alpha1.SkipReasonSuspended)))
+
+			// The skipped token does not trigger a run once the Cron is resumed.
+			cron.Spec.Suspend = ptr.To(false)
+			Expect(k8sClient.Update(ctx, cron)).To(Succeed())
+			_, err = r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(apierrors.IsNotFound(k8sClient.Get(ctx, workloadKey, workload))).To(BeTrue())
+		})
+
+		It("should retry a failed run after the backoff", func() {
+			r := NewCronReconciler(scheme, k8sClient, k8sClient, record.NewFakeRecorder(10), nil, nil)
+
+			cron := &v1alpha1.Cron{}
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			cron.Spec.RetryPolicy = &v1alpha1.CronRetryPolicy{MaxRetries: 1, Backoff: &metav1.Duration{Duration: time.Second}}
+			Expect(k8sClient.Update(ctx, cron)).To(Succeed())
+			cron.Status.LastScheduleTime = &metav1.Time{Time: time.Now().Add(-90 * time.Second)}
+			Expect(k8sClient.Status().Update(ctx, cron)).To(Succeed())
+
+			_, err := r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			uList := &unstructured.UnstructuredList{}
+			uList.SetGroupVersionKind(kubeflowv1.SchemeGroupVersion.WithKind("PyTorchJob"))
+			Expect(k8sClient.List(ctx, uList, client.InNamespace(namespace), client.MatchingLabels{common.LabelCronName: name})).To(Succeed())
+			Expect(uList.Items).To(HaveLen(1))
+			original := uList.Items[0]
+
+			// Fail the run long enough ago for the backoff to have elapsed.
+			original.Object["status"] = map[string]interface{}{
+				"conditions": []interface{}{map[string]interface{}{
+					"type":               string(kubeflowv1.JobFailed),
+					"status":             string(corev1.ConditionTrue),
+					"lastTransitionTime": time.Now().Add(-time.Minute).UTC().Format(time.RFC3339),
+				}},
+				"replicaStatuses": map[string]interface{}{},
+			}
+			Expect(k8sClient.Status().Update(ctx, &original)).To(Succeed())
+
+			_, err = r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			retry := &unstructured.Unstructured{}
+			retry.SetGroupVersionKind(kubeflowv1.SchemeGroupVersion.WithKind("PyTorchJob"))
+			retryKey := types.NamespacedName{Namespace: namespace, Name: getRetryJobName(original.GetName(), 1)}
+			Expect(k8sClient.Get(ctx, retryKey, retry)).To(Succeed())
+			Expect(retry.GetAnnotations()).To(HaveKeyWithValue(common.AnnotationCronRetryOf, original.GetName()))
+			Expect(retry.GetAnnotations()).To(HaveKeyWithValue(common.AnnotationCronRetryAttempt, "1"))
+			Expect(retry.GetAnnotations()).To(HaveKeyWithValue(common.AnnotationCronScheduleTime, original.GetAnnotations()[common.AnnotationCronScheduleTime]))
+
+			Expect(metav1.IsControlledBy(retry, cron)).To(BeTrue())
+
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			Expect(cron.Status.History).To(ContainElement(HaveField("Object.Name", original.GetName())))
+		})
+
+		It("should suspend a run exceeding the run timeout", func() {
+			recorder := record.NewFakeRecorder(10)
+			r := NewCronReconciler(scheme, k8sClient, k8sClient, recorder, nil, nil)
+
+			cron := &v1alpha1.Cron{}
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			// The job needs a valid spec to be suspended.
+			cron.Spec.Template.Workload = &runtime.RawExtension{
+				Raw: []byte(`{"apiVersion":"kubeflow.org/v1","kind":"PyTorchJob","spec":{"pytorchReplicaSpecs":{"Master":{"template":{"spec":{"containers":[{"name":"pytorch","image":"pytorch"}]}}}}}}`),
+			}
+			Expect(k8sClient.Update(ctx, cron)).To(Succeed())
+			cron.Status.LastScheduleTime = &metav1.Time{Time: time.Now().Add(-90 * time.Second)}
+			Expect(k8sClient.Status().Update(ctx, cron)).To(Succeed())
+
+			_, err := r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			uList := &unstructured.UnstructuredList{}
+			uList.SetGroupVersionKind(kubeflowv1.SchemeGroupVersion.WithKind("PyTorchJob"))
+			Expect(k8sClient.List(ctx, uList, client.InNamespace(namespace), client.MatchingLabels{common.LabelCronName: name})).To(Succeed())
+			Expect(uList.Items).To(HaveLen(1))
+
+			// The run has been running longer than the new run timeout.
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			cron.Spec.RunTimeout = &metav1.Duration{Duration: time.Nanosecond}
+			Expect(k8sClient.Update(ctx, cron)).To(Succeed())
+
+			_, err = r.Reconcile(ctx, req)
 			Expect(err).NotTo(HaveOccurred())
+			Expect(recorder.Events).To(Receive(ContainSubstring("TimedOut")))
 
-			// Check if PyTorchJob was created
-			uList := &unstructured.UnstructuredList{}
-			uList.SetGroupVersionKind(kubeflowv1.SchemeGroupVersion.WithKind("PyTorchJob"))
-			Eventually(func() int {
-				_ = k8sClient.List(ctx, uList, client.InNamespace(namespace), client.MatchingLabels{common.LabelCronName: name})
-				return len(uList.Items)
-			}, time.Second*5, time.Millisecond*500).Should(BeNumerically(">=", 1))
+			workload := &unstructured.Unstructured{}
+			workload.SetGroupVersionKind(kubeflowv1.SchemeGroupVersion.WithKind("PyTorchJob"))
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(&uList.Items[0]), workload)).To(Succeed())
+			Expect(workload.GetAnnotations()).To(HaveKey(common.AnnotationCronTimedOut))
+			suspend, _, _ := unstructured.NestedBool(workload.Object, "spec", "runPolicy", "suspend")
+			Expect(suspend).To(BeTrue())
+
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			Expect(cron.Status.Active).To(BeEmpty())
+			Expect(cron.Status.History).To(ContainElement(HaveField("Status", v1alpha1.JobTimedOut)))
 		})
 
-		It("should not create a workload if suspended", func() {
-			r := NewCronReconciler(scheme, k8sClient, k8sClient, nil)
+		It("should wait until the start time", func() {
+			r := NewCronReconciler(scheme, k8sClient, k8sClient, nil, nil, nil)
 
 			cron := &v1alpha1.Cron{}
 			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
-			cron.Spec.Suspend = ptr.To(true)
+			startTime := time.Now().Add(time.Hour).Truncate(time.Second)
+			cron.Spec.StartTime = &metav1.Time{Time: startTime}
 			Expect(k8sClient.Update(ctx, cron)).To(Succeed())
+			cron.Status.LastScheduleTime = &metav1.Time{Time: time.Now().Add(-2 * time.Minute)}
+			Expect(k8sClient.Status().Update(ctx, cron)).To(Succeed())
 
-			_, err := r.Reconcile(ctx, req)
+			result, err := r.Reconcile(ctx, req)
 			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeNumerically("~", time.Hour, time.Minute))
+
+			Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+			condition := meta.FindStatusCondition(cron.Status.Conditions, v1alpha1.CronConditionScheduling)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(v1alpha1.CronReasonWaitingForStartTime))
 
-			// Check that no PyTorchJob was created
 			uList := &unstructured.UnstructuredList{}
 			uList.SetGroupVersionKind(kubeflowv1.SchemeGroupVersion.WithKind("PyTorchJob"))
-			Consistently(func() int {
-				_ = k8sClient.List(ctx, uList, client.InNamespace(namespace))
-				return len(uList.Items)
-			}, time.Second*2, time.Millisecond*500).Should(Equal(0))
+			Expect(k8sClient.List(ctx, uList, client.InNamespace(namespace))).To(Succeed())
+			Expect(uList.Items).To(BeEmpty())
 		})
 	})
 
@@ -133,7 +1083,7 @@ var _ = Describe("Cron Controller", func() {
 		var r *CronReconciler
 
 		BeforeEach(func() {
-			r = NewCronReconciler(scheme, k8sClient, k8sClient, nil)
+			r = NewCronReconciler(scheme, k8sClient, k8sClient, nil, nil, nil)
 		})
 
 		It("newWorkloadFromTemplate should populate metadata", func() {
@@ -151,7 +1101,7 @@ var _ = Describe("Cron Controller", func() {
 				},
 			}
 			t := time.Now()
-			w, err := r.newWorkloadFromTemplate(cron, t)
+			w, err := r.newWorkloadFromTemplate(cron, "", t)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(w.GetName()).To(Equal(getDefaultJobName(cron, t)))
 			Expect(w.GetNamespace()).To(Equal(namespace))
@@ -164,7 +1114,7 @@ var _ = Describe("Cron Controller", func() {
 		now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
 
 		BeforeEach(func() {
-			r = NewCronReconciler(scheme, k8sClient, k8sClient, nil)
+			r = NewCronReconciler(scheme, k8sClient, k8sClient, nil, nil, nil)
 		})
 
 		It("should return error when cron is unparsable", func() {
@@ -179,9 +1129,9 @@ var _ = Describe("Cron Controller", func() {
 				},
 			}
 
-			lastMissed, next, err := r.getNextSchedule(ctx, cron, now)
+			missedRuns, next, err := r.getNextSchedule(ctx, cron, getCronSchedules(cron)[0], nil, now)
 			Expect(err.Error()).To(ContainSubstring("unparsable cron"))
-			Expect(lastMissed.IsZero()).To(BeTrue())
+			Expect(missedRuns).To(BeEmpty())
 			Expect(next.IsZero()).To(BeTrue())
 		})
 
@@ -197,13 +1147,13 @@ var _ = Describe("Cron Controller", func() {
 				},
 			}
 
-			lastMissed, next, err := r.getNextSchedule(ctx, cron, now)
+			missedRuns, next, err := r.getNextSchedule(ctx, cron, getCronSchedules(cron)[0], nil, now)
 			Expect(err.Error()).To(ContainSubstring("unschedulable cron"))
-			Expect(lastMissed.IsZero()).To(BeTrue())
+			Expect(missedRuns).To(BeEmpty())
 			Expect(next.IsZero()).To(BeTrue())
 		})
 
-		It("should return lastMissed and next schedule", func() {
+		It("should return missed runs and next schedule", func() {
 			cron := &v1alpha1.Cron{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:              name,
@@ -215,10 +1165,535 @@ var _ = Describe("Cron Controller", func() {
 				},
 			}
 
-			lastMissed, next, err := r.getNextSchedule(ctx, cron, now)
+			missedRuns, next, err := r.getNextSchedule(ctx, cron, getCronSchedules(cron)[0], nil, now)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(lastMissed).To(Equal(now))
+			Expect(missedRuns).To(HaveExactElements(BeTemporally("==", now)))
 			Expect(next.Unix()).To(Equal(now.Add(1 * time.Minute).Unix()))
 		})
+
+		It("should evaluate schedule in the specified time zone", func() {
+			cron := &v1alpha1.Cron{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              name,
+					Namespace:         namespace,
+					CreationTimestamp: metav1.NewTime(now.Add(-1 * time.Hour)),
+				},
+				Spec: v1alpha1.CronSpec{
+					Schedule: "0 21 * * *",
+					TimeZone: ptr.To("Asia/Shanghai"),
+				},
+			}
+
+			missedRuns, next, err := r.getNextSchedule(ctx, cron, getCronSchedules(cron)[0], nil, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(missedRuns).To(BeEmpty())
+			Expect(next.UTC()).To(Equal(time.Date(2026, 1, 1, 13, 0, 0, 0, time.UTC)))
+			Expect(cron.Status.TimeZone).To(Equal("Asia/Shanghai"))
+		})
+
+		It("should skip missed run past the starting deadline", func() {
+			recorder := record.NewFakeRecorder(10)
+			r = NewCronReconciler(scheme, k8sClient, k8sClient, recorder, nil, nil)
+			cron := &v1alpha1.Cron{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              name,
+					Namespace:         namespace,
+					CreationTimestamp: metav1.NewTime(now.Add(-5 * time.Hour)),
+				},
+				Spec: v1alpha1.CronSpec{
+					Schedule:                "0 9 * * *",
+					TimeZone:                ptr.To("UTC"),
+					StartingDeadlineSeconds: ptr.To[int64](3600),
+				},
+			}
+
+			missedRuns, next, err := r.getNextSchedule(ctx, cron, getCronSchedules(cron)[0], nil, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(missedRuns).To(BeEmpty())
+			Expect(next).To(BeTemporally("==", time.Date(2026, 1, 2, 9, 0, 0, 0, time.UTC)))
+			Expect(cron.Status.SkippedRuns).To(HaveLen(1))
+			Expect(cron.Status.SkippedRuns[0].ScheduleTime.Time).To(BeTemporally("==", time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)))
+			Expect(cron.Status.SkippedRuns[0].Reason).To(Equal(v1alpha1.SkipReasonStartingDeadlineExceeded))
+			Expect(recorder.Events).To(HaveLen(1))
+
+			// The same skipped run should only be recorded once.
+			_, _, err = r.getNextSchedule(ctx, cron, getCronSchedules(cron)[0], nil, now.Add(time.Minute))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cron.Status.SkippedRuns).To(HaveLen(1))
+			Expect(recorder.Events).To(HaveLen(1))
+		})
+
+		It("should only record the latest missed run past the starting deadline", func() {
+			recorder := record.NewFakeRecorder(10)
+			r = NewCronReconciler(scheme, k8sClient, k8sClient, recorder, nil, nil)
+			cron := &v1alpha1.Cron{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              name,
+					Namespace:         namespace,
+					CreationTimestamp: metav1.NewTime(now.Add(-55 * time.Minute)),
+				},
+				Spec: v1alpha1.CronSpec{
+					Schedule:                "*/10 * * * *",
+					TimeZone:                ptr.To("UTC"),
+					CatchUpPolicy:           v1alpha1.CatchUpPolicyAll,
+					StartingDeadlineSeconds: ptr.To[int64](900),
+				},
+			}
+
+			missedRuns, _, err := r.getNextSchedule(ctx, cron, getCronSchedules(cron)[0], nil, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(missedRuns).To(HaveExactElements(
+				BeTemporally("==", now.Add(-10*time.Minute)),
+				BeTemporally("==", now),
+			))
+			Expect(cron.Status.SkippedRuns).To(HaveExactElements(
+				HaveField("ScheduleTime.Time", BeTemporally("==", now.Add(-20*time.Minute))),
+			))
+			Expect(cron.Status.SkippedRuns[0].Reason).To(Equal(v1alpha1.SkipReasonStartingDeadlineExceeded))
+			Expect(recorder.Events).To(HaveLen(1))
+		})
+
+		It("should not look at every scheduled time past the starting deadline", func() {
+			r = NewCronReconciler(scheme, k8sClient, k8sClient, record.NewFakeRecorder(10), nil, nil)
+			cron := &v1alpha1.Cron{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              name,
+					Namespace:         namespace,
+					CreationTimestamp: metav1.NewTime(now.AddDate(-10, 0, 0)),
+				},
+				Spec: v1alpha1.CronSpec{
+					Schedule:                "* * * * * *",
+					ScheduleFormat:          v1alpha1.ScheduleFormatWithSeconds,
+					TimeZone:                ptr.To("UTC"),
+					StartingDeadlineSeconds: ptr.To[int64](600),
+				},
+			}
+
+			missedRuns, _, err := r.getNextSchedule(ctx, cron, getCronSchedules(cron)[0], nil, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(missedRuns).To(HaveExactElements(BeTemporally("==", now)))
+			Expect(cron.Status.SkippedRuns).To(HaveExactElements(
+				HaveField("ScheduleTime.Time", BeTemporally("==", now.Add(-600*time.Second))),
+			))
+		})
+
+		It("should not return missed runs before the start time", func() {
+			cron := &v1alpha1.Cron{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              name,
+					Namespace:         namespace,
+					CreationTimestamp: metav1.NewTime(now.Add(-3 * time.Hour)),
+				},
+				Spec: v1alpha1.CronSpec{
+					Schedule:      "0 * * * *",
+					TimeZone:      ptr.To("UTC"),
+					CatchUpPolicy: v1alpha1.CatchUpPolicyAll,
+					StartTime:     &metav1.Time{Time: now.Add(-time.Hour)},
+				},
+			}
+
+			missedRuns, next, err := r.getNextSchedule(ctx, cron, getCronSchedules(cron)[0], nil, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(missedRuns).To(HaveExactElements(
+				BeTemporally("==", now.Add(-time.Hour)),
+				BeTemporally("==", now),
+			))
+			Expect(next).To(BeTemporally("==", now.Add(time.Hour)))
+
+			cron.Spec.StartTime = &metav1.Time{Time: now.Add(90 * time.Minute)}
+			missedRuns, next, err = r.getNextSchedule(ctx, cron, getCronSchedules(cron)[0], nil, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(missedRuns).To(BeEmpty())
+			Expect(next).To(BeTemporally("==", now.Add(2*time.Hour)))
+		})
+
+		It("should return missed run within the starting deadline", func() {
+			cron := &v1alpha1.Cron{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              name,
+					Namespace:         namespace,
+					CreationTimestamp: metav1.NewTime(now.Add(-5 * time.Hour)),
+				},
+				Spec: v1alpha1.CronSpec{
+					Schedule:                "30 11 * * *",
+					TimeZone:                ptr.To("UTC"),
+					StartingDeadlineSeconds: ptr.To[int64](3600),
+				},
+			}
+
+			missedRuns, _, err := r.getNextSchedule(ctx, cron, getCronSchedules(cron)[0], nil, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(missedRuns).To(HaveExactElements(BeTemporally("==", time.Date(2026, 1, 1, 11, 30, 0, 0, time.UTC))))
+			Expect(cron.Status.SkippedRuns).To(BeEmpty())
+		})
+
+		It("should use the last schedule time of each schedule", func() {
+			cron := &v1alpha1.Cron{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              name,
+					Namespace:         namespace,
+					CreationTimestamp: metav1.NewTime(now.Add(-150 * time.Minute)),
+				},
+				Spec: v1alpha1.CronSpec{
+					Schedules: []v1alpha1.CronSchedule{
+						{Name: "a", Schedule: "0 * * * *"},
+						{Name: "b", Schedule: "0 * * * *"},
+					},
+					TimeZone: ptr.To("UTC"),
+				},
+				Status: v1alpha1.CronStatus{
+					LastScheduleTime: ptr.To(metav1.NewTime(now)),
+					Schedules: []v1alpha1.CronScheduleStatus{
+						{Name: "a", LastScheduleTime: ptr.To(metav1.NewTime(now))},
+					},
+				},
+			}
+			schedules := getCronSchedules(cron)
+
+			missedRuns, _, err := r.getNextSchedule(ctx, cron, schedules[0], nil, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(missedRuns).To(BeEmpty())
+
+			missedRuns, _, err = r.getNextSchedule(ctx, cron, schedules[1], nil, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(missedRuns).To(HaveExactElements(BeTemporally("==", now)))
+		})
+
+		It("should support seconds field in format with seconds", func() {
+			cron := &v1alpha1.Cron{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              name,
+					Namespace:         namespace,
+					CreationTimestamp: metav1.NewTime(now.Add(-5 * time.Minute)),
+				},
+				Spec: v1alpha1.CronSpec{
+					Schedule:       "30 */1 * * * *",
+					ScheduleFormat: v1alpha1.ScheduleFormatWithSeconds,
+					TimeZone:       ptr.To("UTC"),
+				},
+			}
+
+			missedRuns, next, err := r.getNextSchedule(ctx, cron, getCronSchedules(cron)[0], nil, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(missedRuns).To(HaveExactElements(BeTemporally("==", now.Add(-30*time.Second))))
+			Expect(next).To(BeTemporally("==", now.Add(30*time.Second)))
+		})
+
+		It("should delay runs by the jitter offset", func() {
+			cron := &v1alpha1.Cron{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              name,
+					Namespace:         namespace,
+					UID:               "6b4c8a5e-3f2d-4e1a-9c7b-0d8e2f1a3b5c",
+					CreationTimestamp: metav1.NewTime(now.Add(-150 * time.Minute)),
+				},
+				Spec: v1alpha1.CronSpec{
+					Schedule: "0 * * * *",
+					TimeZone: ptr.To("UTC"),
+					Jitter:   &metav1.Duration{Duration: 10 * time.Minute},
+				},
+			}
+			offset := getJitterOffset(cron)
+			Expect(offset).To(BeNumerically(">", 0))
+
+			// The run scheduled at now is not due until the offset has passed.
+			missedRuns, next, err := r.getNextSchedule(ctx, cron, getCronSchedules(cron)[0], nil, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(missedRuns).To(HaveExactElements(BeTemporally("==", now.Add(-time.Hour))))
+			Expect(next).To(BeTemporally("==", now.Add(offset)))
+
+			// Missed runs keep their scheduled times so that workload names stay the same.
+			missedRuns, next, err = r.getNextSchedule(ctx, cron, getCronSchedules(cron)[0], nil, now.Add(offset))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(missedRuns).To(HaveExactElements(BeTemporally("==", now)))
+			Expect(next).To(BeTemporally("==", now.Add(time.Hour+offset)))
+		})
+
+		It("should only return the latest missed run by default", func() {
+			cron := &v1alpha1.Cron{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              name,
+					Namespace:         namespace,
+					CreationTimestamp: metav1.NewTime(now.Add(-150 * time.Minute)),
+				},
+				Spec: v1alpha1.CronSpec{
+					Schedule: "0 * * * *",
+					TimeZone: ptr.To("UTC"),
+				},
+			}
+
+			missedRuns, _, err := r.getNextSchedule(ctx, cron, getCronSchedules(cron)[0], nil, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(missedRuns).To(HaveExactElements(BeTemporally("==", now)))
+		})
+
+		It("should return all missed runs with catch-up policy All", func() {
+			cron := &v1alpha1.Cron{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              name,
+					Namespace:         namespace,
+					CreationTimestamp: metav1.NewTime(now.Add(-150 * time.Minute)),
+				},
+				Spec: v1alpha1.CronSpec{
+					Schedule:      "0 * * * *",
+					TimeZone:      ptr.To("UTC"),
+					CatchUpPolicy: v1alpha1.CatchUpPolicyAll,
+				},
+			}
+
+			missedRuns, _, err := r.getNextSchedule(ctx, cron, getCronSchedules(cron)[0], nil, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(missedRuns).To(HaveExactElements(
+				BeTemporally("==", now.Add(-2*time.Hour)),
+				BeTemporally("==", now.Add(-1*time.Hour)),
+				BeTemporally("==", now),
+			))
+			Expect(cron.Status.SkippedRuns).To(BeEmpty())
+		})
+
+		It("should skip missed runs beyond the catch-up limit", func() {
+			recorder := record.NewFakeRecorder(10)
+			r = NewCronReconciler(scheme, k8sClient, k8sClient, recorder, nil, nil)
+			cron := &v1alpha1.Cron{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              name,
+					Namespace:         namespace,
+					CreationTimestamp: metav1.NewTime(now.Add(-330 * time.Minute)),
+				},
+				Spec: v1alpha1.CronSpec{
+					Schedule:       "0 * * * *",
+					TimeZone:       ptr.To("UTC"),
+					CatchUpPolicy:  v1alpha1.CatchUpPolicyAll,
+					MaxCatchUpRuns: ptr.To[int32](2),
+				},
+			}
+
+			missedRuns, _, err := r.getNextSchedule(ctx, cron, getCronSchedules(cron)[0], nil, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(missedRuns).To(HaveExactElements(
+				BeTemporally("==", now.Add(-1*time.Hour)),
+				BeTemporally("==", now),
+			))
+			Expect(cron.Status.SkippedRuns).To(HaveLen(1))
+			Expect(cron.Status.SkippedRuns[0].ScheduleTime.Time).To(BeTemporally("==", now.Add(-2*time.Hour)))
+			Expect(cron.Status.SkippedRuns[0].Reason).To(Equal(v1alpha1.SkipReasonCatchUpLimitExceeded))
+			Expect(recorder.Events).To(HaveLen(1))
+		})
+
+		It("should not return missed runs with catch-up policy None", func() {
+			recorder := record.NewFakeRecorder(10)
+			r = NewCronReconciler(scheme, k8sClient, k8sClient, recorder, nil, nil)
+			cron := &v1alpha1.Cron{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              name,
+					Namespace:         namespace,
+					CreationTimestamp: metav1.NewTime(now.Add(-150 * time.Minute)),
+				},
+				Spec: v1alpha1.CronSpec{
+					Schedule:      "0 * * * *",
+					TimeZone:      ptr.To("UTC"),
+					CatchUpPolicy: v1alpha1.CatchUpPolicyNone,
+				},
+			}
+
+			// A run observed on time is not missed.
+			missedRuns, _, err := r.getNextSchedule(ctx, cron, getCronSchedules(cron)[0], nil, now.Add(10*time.Second))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(missedRuns).To(HaveExactElements(BeTemporally("==", now)))
+
+			missedRuns, next, err := r.getNextSchedule(ctx, cron, getCronSchedules(cron)[0], nil, now.Add(10*time.Minute))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(missedRuns).To(BeEmpty())
+			Expect(next).To(BeTemporally("==", now.Add(time.Hour)))
+			Expect(cron.Status.SkippedRuns).To(HaveLen(1))
+			Expect(cron.Status.SkippedRuns[0].ScheduleTime.Time).To(BeTemporally("==", now))
+			Expect(cron.Status.SkippedRuns[0].Reason).To(Equal(v1alpha1.SkipReasonNotCaughtUp))
+			Expect(recorder.Events).To(HaveLen(1))
+		})
+
+		It("should not skip runs throttled by the rate limit for being late", func() {
+			recorder := record.NewFakeRecorder(10)
+			r = NewCronReconciler(scheme, k8sClient, k8sClient, recorder, nil, nil)
+			cron := &v1alpha1.Cron{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              name,
+					Namespace:         namespace,
+					CreationTimestamp: metav1.NewTime(now.Add(-30 * time.Minute)),
+				},
+				Spec: v1alpha1.CronSpec{
+					Schedule:                "0 * * * *",
+					TimeZone:                ptr.To("UTC"),
+					CatchUpPolicy:           v1alpha1.CatchUpPolicyNone,
+					StartingDeadlineSeconds: ptr.To[int64](60),
+				},
+			}
+			sched := getCronSchedules(cron)[0]
+			r.throttled.set(client.ObjectKeyFromObject(cron), sched.name, true, now.Add(30*time.Second))
+
+			// The run is judged late from the time its creation was first throttled.
+			missedRuns, next, err := r.getNextSchedule(ctx, cron, sched, nil, now.Add(10*time.Minute))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(missedRuns).To(HaveExactElements(BeTemporally("==", now)))
+			Expect(next).To(BeTemporally("==", now.Add(time.Hour)))
+			Expect(cron.Status.SkippedRuns).To(BeEmpty())
+
+			// It is late again once created.
+			r.throttled.set(client.ObjectKeyFromObject(cron), sched.name, false, now.Add(10*time.Minute))
+			missedRuns, _, err = r.getNextSchedule(ctx, cron, sched, nil, now.Add(10*time.Minute))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(missedRuns).To(BeEmpty())
+			Expect(cron.Status.SkippedRuns).To(HaveLen(1))
+			Expect(cron.Status.SkippedRuns[0].Reason).To(Equal(v1alpha1.SkipReasonStartingDeadlineExceeded))
+		})
+
+		It("should skip runs blocked by calendars", func() {
+			recorder := record.NewFakeRecorder(10)
+			r = NewCronReconciler(scheme, k8sClient, k8sClient, recorder, nil, nil)
+			cron := &v1alpha1.Cron{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              name,
+					Namespace:         namespace,
+					CreationTimestamp: metav1.NewTime(now.Add(-150 * time.Minute)),
+				},
+				Spec: v1alpha1.CronSpec{
+					Schedule:      "0 * * * *",
+					TimeZone:      ptr.To("UTC"),
+					CatchUpPolicy: v1alpha1.CatchUpPolicyAll,
+				},
+			}
+			cal, err := calendar.New(&v1alpha1.CronCalendar{
+				ObjectMeta: metav1.ObjectMeta{Name: "maintenance", Namespace: namespace},
+				Spec: v1alpha1.CronCalendarSpec{
+					TimeZone: ptr.To("UTC"),
+					ExcludedWindows: []v1alpha1.CronCalendarWindow{{
+						Start:  &metav1.Time{Time: now.Add(-90 * time.Minute)},
+						End:    &metav1.Time{Time: now.Add(90 * time.Minute)},
+						Reason: "cluster maintenance",
+					}},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			missedRuns, next, err := r.getNextSchedule(ctx, cron, getCronSchedules(cron)[0], calendar.Calendars{cal}, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(missedRuns).To(HaveExactElements(BeTemporally("==", now.Add(-2*time.Hour))))
+			Expect(next).To(BeTemporally("==", now.Add(2*time.Hour)))
+			Expect(cron.Status.SkippedRuns).To(HaveLen(2))
+			Expect(cron.Status.SkippedRuns[0].ScheduleTime.Time).To(BeTemporally("==", now.Add(-time.Hour)))
+			Expect(cron.Status.SkippedRuns[0].Reason).To(Equal(v1alpha1.SkipReasonBlockedByCalendar))
+			Expect(cron.Status.SkippedRuns[0].Message).To(ContainSubstring("calendar maintenance: cluster maintenance"))
+			Expect(cron.Status.SkippedRuns[1].ScheduleTime.Time).To(BeTemporally("==", now))
+			Expect(recorder.Events).To(HaveLen(2))
+		})
+	})
+
+	Context("setWorkloadKindNotFound", func() {
+		var (
+			r        *CronReconciler
+			recorder *record.FakeRecorder
+			cron     *v1alpha1.Cron
+			gvk      = schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Training"}
+		)
+
+		BeforeEach(func() {
+			recorder = record.NewFakeRecorder(10)
+			r = NewCronReconciler(scheme, k8sClient, k8sClient, recorder, nil, nil)
+			cron = &v1alpha1.Cron{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+		})
+
+		It("should look the kind up again after the minimum backoff once it becomes unavailable", func() {
+			Expect(r.setWorkloadKindNotFound(ctx, cron, gvk)).To(Equal(minWorkloadKindBackoff))
+			Expect(meta.IsStatusConditionFalse(cron.Status.Conditions, v1alpha1.CronConditionWorkloadKindAvailable)).To(BeTrue())
+			Expect(recorder.Events).To(HaveLen(1))
+		})
+
+		It("should wait as long as the kind has been unavailable", func() {
+			cron.Status.Conditions = []metav1.Condition{{
+				Type:               v1alpha1.CronConditionWorkloadKindAvailable,
+				Status:             metav1.ConditionFalse,
+				Reason:             v1alpha1.CronReasonWorkloadKindNotFound,
+				LastTransitionTime: metav1.Time{Time: time.Now().Add(-time.Minute)},
+			}}
+			Expect(r.setWorkloadKindNotFound(ctx, cron, gvk)).To(BeNumerically("~", time.Minute, time.Second))
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("should not wait longer than the maximum backoff", func() {
+			cron.Status.Conditions = []metav1.Condition{{
+				Type:               v1alpha1.CronConditionWorkloadKindAvailable,
+				Status:             metav1.ConditionFalse,
+				Reason:             v1alpha1.CronReasonWorkloadKindNotFound,
+				LastTransitionTime: metav1.Time{Time: time.Now().Add(-time.Hour)},
+			}}
+			Expect(r.setWorkloadKindNotFound(ctx, cron, gvk)).To(Equal(maxWorkloadKindBackoff))
+		})
+	})
+
+	Context("admitRuns", func() {
+		var (
+			r   *CronReconciler
+			gvk = kubeflowv1.SchemeGroupVersion.WithKind("PyTorchJob")
+		)
+
+		newActive := func(name, schedule string) client.Object {
+			workload := &unstructured.Unstructured{}
+			workload.SetGroupVersionKind(gvk)
+			workload.SetName(name)
+			workload.SetLabels(map[string]string{common.LabelCronSchedule: schedule})
+			return workload
+		}
+
+		BeforeEach(func() {
+			r = NewCronReconciler(scheme, k8sClient, k8sClient, record.NewFakeRecorder(10), nil, nil)
+		})
+
+		It("should admit every run without limits", func() {
+			cron := &v1alpha1.Cron{Spec: v1alpha1.CronSpec{ConcurrencyPolicy: v1alpha1.ConcurrentPolicyAllow}}
+			admission, err := r.admitRuns(ctx, cron, gvk, nil, "", 3, []client.Object{newActive("a", "")})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(admission.count).To(Equal(3))
+			Expect(admission.reason).To(BeEmpty())
+		})
+
+		It("should apply the concurrency policy to the active runs of the schedule", func() {
+			cron := &v1alpha1.Cron{Spec: v1alpha1.CronSpec{ConcurrencyPolicy: v1alpha1.ConcurrentPolicyForbid}}
+			active := []client.Object{newActive("a", "weekdays")}
+			admission, err := r.admitRuns(ctx, cron, gvk, nil, "weekdays", 2, active)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(admission.count).To(BeZero())
+			Expect(admission.reason).To(Equal(v1alpha1.SkipReasonConcurrencyForbidden))
+
+			admission, err = r.admitRuns(ctx, cron, gvk, nil, "weekends", 2, active)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(admission.count).To(Equal(1))
+
+			// Runs without a schedule are limited by the active runs of all schedules.
+			admission, err = r.admitRuns(ctx, cron, gvk, nil, "", 1, active)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(admission.count).To(BeZero())
+		})
+
+		It("should wait for room in the concurrency group", func() {
+			cron := &v1alpha1.Cron{Spec: v1alpha1.CronSpec{ConcurrencyPolicy: v1alpha1.ConcurrentPolicyAllow}}
+			admission, err := r.admitRuns(ctx, cron, gvk, &concurrencyGroup{limit: 3, othersActive: 1}, "", 3, []client.Object{newActive("a", "")})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(admission.count).To(Equal(1))
+			Expect(admission.skipExcess).To(BeFalse())
+			Expect(admission.reason).To(Equal(v1alpha1.SkipReasonConcurrencyGroupFull))
+
+			admission, err = r.admitRuns(ctx, cron, gvk, &concurrencyGroup{limit: 1, othersActive: 1}, "", 1, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(admission.count).To(BeZero())
+			Expect(admission.reason).To(Equal(v1alpha1.SkipReasonConcurrencyGroupFull))
+		})
+
+		It("should skip the runs beyond the maximum number of active runs", func() {
+			cron := &v1alpha1.Cron{Spec: v1alpha1.CronSpec{MaxActiveRuns: ptr.To[int32](2)}}
+			admission, err := r.admitRuns(ctx, cron, gvk, nil, "", 3, []client.Object{newActive("a", "")})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(admission.count).To(Equal(1))
+			Expect(admission.skipExcess).To(BeTrue())
+			Expect(admission.reason).To(Equal(v1alpha1.SkipReasonMaxActiveRunsReached))
+		})
 	})
 })
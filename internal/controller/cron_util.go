@@ -19,19 +19,31 @@ package controller
 import (
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
 	"slices"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
 	kubeflowutil "github.com/kubeflow/training-operator/pkg/util"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/AliyunContainerService/cron-operator/api/v1alpha1"
+	"github.com/AliyunContainerService/cron-operator/internal/schedule"
+	"github.com/AliyunContainerService/cron-operator/internal/statusrule"
+	"github.com/AliyunContainerService/cron-operator/pkg/common"
 )
 
 // newEmptyWorkload creates an empty Unstructured object based on the workload template
@@ -64,16 +76,546 @@ func getWorkloadGVK(cron *v1alpha1.Cron) (schema.GroupVersionKind, error) {
 	return workload.GetObjectKind().GroupVersionKind(), nil
 }
 
+// setPodTemplateLabel sets the given label on the pod templates nested in the given object, e.g. the replica
+// templates of a PyTorchJob or the head and worker group templates of a RayJob. Pod templates are found as
+// the objects whose spec has containers, whatever the kind of the workload.
+func setPodTemplateLabel(obj map[string]interface{}, key, value string) {
+	if _, ok, _ := unstructured.NestedFieldNoCopy(obj, "spec", "containers"); ok {
+		labels, _, err := unstructured.NestedStringMap(obj, "metadata", "labels")
+		if err != nil {
+			return
+		}
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[key] = value
+		_ = unstructured.SetNestedStringMap(obj, labels, "metadata", "labels")
+		return
+	}
+	for _, field := range obj {
+		switch field := field.(type) {
+		case map[string]interface{}:
+			setPodTemplateLabel(field, key, value)
+		case []interface{}:
+			for _, item := range field {
+				if item, ok := item.(map[string]interface{}); ok {
+					setPodTemplateLabel(item, key, value)
+				}
+			}
+		}
+	}
+}
+
 // getDefaultJobName generates a unique name for a scheduled job by appending
 // the Unix timestamp of the schedule to the Cron object's name.
 func getDefaultJobName(cron *v1alpha1.Cron, scheduleTime time.Time) string {
 	return fmt.Sprintf("%s-%d", cron.Name, scheduleTime.Unix())
 }
 
+// getJitterOffset returns the delay of the runs of the given Cron within its jitter. The offset
+// is derived from the UID of the Cron, so that it stays the same across controller restarts.
+func getJitterOffset(cron *v1alpha1.Cron) time.Duration {
+	if cron.Spec.Jitter == nil || cron.Spec.Jitter.Duration < time.Second {
+		return 0
+	}
+
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(cron.UID))
+	seconds := h.Sum64() % uint64(cron.Spec.Jitter.Duration/time.Second)
+	return time.Duration(seconds) * time.Second
+}
+
+// delayByOffset delays the given scheduled time by the jitter offset.
+// A zero time means there is no scheduled time and is returned as is.
+func delayByOffset(scheduleTime time.Time, offset time.Duration) time.Time {
+	if scheduleTime.IsZero() {
+		return scheduleTime
+	}
+	return scheduleTime.Add(offset)
+}
+
+// cronSchedule is one of the schedules of a Cron.
+type cronSchedule struct {
+	// name identifies the schedule when the Cron has multiple schedules,
+	// and is empty for the single schedule specified by spec.schedule.
+	name string
+
+	// schedule is the cron expression of the schedule.
+	schedule string
+}
+
+// getCronSchedules returns the schedules of the given Cron. Schedules that are not
+// named are identified by their index in the list.
+func getCronSchedules(cron *v1alpha1.Cron) []cronSchedule {
+	if len(cron.Spec.Schedules) == 0 {
+		return []cronSchedule{{schedule: cron.Spec.Schedule}}
+	}
+
+	schedules := make([]cronSchedule, len(cron.Spec.Schedules))
+	for i, s := range cron.Spec.Schedules {
+		name := s.Name
+		if name == "" {
+			name = strconv.Itoa(i)
+		}
+		schedules[i] = cronSchedule{name: name, schedule: s.Schedule}
+	}
+	return schedules
+}
+
+// normalizeSchedules returns the normalized cron expressions of the given schedules separated by semicolons.
+func normalizeSchedules(schedules []cronSchedule) string {
+	normalized := make([]string, len(schedules))
+	for i, sched := range schedules {
+		normalized[i] = schedule.Normalize(sched.schedule)
+	}
+	return strings.Join(normalized, "; ")
+}
+
+// getScheduleJobName generates a unique name for a job scheduled by the given schedule.
+// Jobs of multiple schedules are named after their schedule as well, so that schedules
+// firing at the same time do not collide.
+func getScheduleJobName(cron *v1alpha1.Cron, schedule string, scheduleTime time.Time) string {
+	if schedule == "" {
+		return getDefaultJobName(cron, scheduleTime)
+	}
+	return fmt.Sprintf("%s-%s-%d", cron.Name, schedule, scheduleTime.Unix())
+}
+
+// getBackfillJobName generates a unique name for a job backfilling a scheduled time of the given schedule.
+// Backfilled jobs are named after their backfill as well, so that they never take the names of the runs of
+// the Cron.
+func getBackfillJobName(cron *v1alpha1.Cron, backfill *v1alpha1.CronBackfill, schedule string, scheduleTime time.Time) string {
+	if schedule == "" {
+		return fmt.Sprintf("%s-backfill-%s-%d", cron.Name, backfill.Name, scheduleTime.Unix())
+	}
+	return fmt.Sprintf("%s-backfill-%s-%s-%d", cron.Name, backfill.Name, schedule, scheduleTime.Unix())
+}
+
+// setAnnotation sets an annotation on the given object.
+func setAnnotation(obj metav1.Object, key, value string) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[key] = value
+	obj.SetAnnotations(annotations)
+}
+
+// getTriggeredJobName generates a name for a job triggered on demand by the given token.
+// The name is derived from the token, so that a token never creates more than one job.
+func getTriggeredJobName(cron *v1alpha1.Cron, token string) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(token))
+	return fmt.Sprintf("%s-manual-%08x", cron.Name, h.Sum32())
+}
+
+// getRetryJobName generates a name for the given retry of the original run.
+func getRetryJobName(original string, attempt int32) string {
+	return fmt.Sprintf("%s-retry-%d", original, attempt)
+}
+
+// getRetryOf returns the name of the original run retried by the given workload and the number
+// of the retry, or the name of the workload and zero if it is not a retry.
+func getRetryOf(workload metav1.Object) (string, int32) {
+	annotations := workload.GetAnnotations()
+	original := annotations[common.AnnotationCronRetryOf]
+	if original == "" {
+		return workload.GetName(), 0
+	}
+	attempt, err := strconv.ParseInt(annotations[common.AnnotationCronRetryAttempt], 10, 32)
+	if err != nil {
+		return original, 0
+	}
+	return original, int32(attempt)
+}
+
+// getRetryBackoff returns the delay after a failure before the given retry, starting at 1,
+// which doubles for each retry up to the maximum backoff of the retry policy.
+func getRetryBackoff(policy *v1alpha1.CronRetryPolicy, attempt int32) time.Duration {
+	backoff := defaultRetryBackoff
+	if policy.Backoff != nil {
+		backoff = policy.Backoff.Duration
+	}
+	maxBackoff := defaultMaxRetryBackoff
+	if policy.MaxBackoff != nil {
+		maxBackoff = policy.MaxBackoff.Duration
+	}
+
+	for i := int32(1); i < attempt && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, maxBackoff)
+}
+
+// getFailedTime returns the time when a job with the given status failed, or the zero time if unknown.
+func getFailedTime(status kubeflowv1.JobStatus) time.Time {
+	for _, condition := range status.Conditions {
+		if condition.Type == kubeflowv1.JobFailed && !condition.LastTransitionTime.IsZero() {
+			return condition.LastTransitionTime.Time
+		}
+	}
+	if status.CompletionTime != nil {
+		return status.CompletionTime.Time
+	}
+	return time.Time{}
+}
+
+// failedRun is the latest attempt of a run of a Cron that has failed and is pending a retry.
+type failedRun struct {
+	// workload is the latest failed attempt of the run.
+	workload client.Object
+	// original is the name of the original run.
+	original string
+	// attempt is the number of the retry of the latest attempt, or zero for the original run.
+	attempt int32
+	// failedTime is the time when the latest attempt failed.
+	failedTime time.Time
+}
+
+// getFailedRuns returns the failed runs among the given workloads that are pending a retry according to
+// the retry policy of the Cron. A run is no longer retried once all its retries have failed, or once a
+// later run of its schedule has been created. The status of the workloads is interpreted by the given status
+// rules if not nil.
+func getFailedRuns(cron *v1alpha1.Cron, workloads []client.Object, rules *statusrule.Program) []failedRun {
+	if cron.Spec.RetryPolicy == nil {
+		return nil
+	}
+
+	// Find the latest attempt of each run.
+	var originals []string
+	latest := map[string]failedRun{}
+	for _, workload := range workloads {
+		original, attempt := getRetryOf(workload)
+		run, ok := latest[original]
+		if !ok {
+			originals = append(originals, original)
+		}
+		if !ok || attempt > run.attempt {
+			latest[original] = failedRun{workload: workload, original: original, attempt: attempt}
+		}
+	}
+
+	var failedRuns []failedRun
+	for _, original := range originals {
+		run := latest[original]
+		if run.attempt >= cron.Spec.RetryPolicy.MaxRetries {
+			continue
+		}
+		status, err := getJobStatus(run.workload, rules)
+		if err != nil || !kubeflowutil.IsFailed(status) {
+			continue
+		}
+
+		created := run.workload.GetCreationTimestamp()
+		schedule := run.workload.GetLabels()[common.LabelCronSchedule]
+		superseded := slices.ContainsFunc(filterWorkloadsBySchedule(workloads, schedule), func(workload client.Object) bool {
+			_, attempt := getRetryOf(workload)
+			return attempt == 0 && workload.GetCreationTimestamp().After(created.Time)
+		})
+		if superseded {
+			continue
+		}
+
+		run.failedTime = getFailedTime(status)
+		if run.failedTime.IsZero() {
+			run.failedTime = created.Time
+		}
+		failedRuns = append(failedRuns, run)
+	}
+	return failedRuns
+}
+
+// newCronHistory returns the history record of the given workload with the given final status.
+func newCronHistory(workload client.Object, status kubeflowv1.JobConditionType) v1alpha1.CronHistory {
+	gvk := workload.GetObjectKind().GroupVersionKind()
+	entry := v1alpha1.CronHistory{
+		UID: workload.GetUID(),
+		Object: corev1.TypedLocalObjectReference{
+			// For backward compatibility, we pass group/version instead of just group.
+			APIGroup: ptr.To(gvk.GroupVersion().String()),
+			Kind:     gvk.Kind,
+			Name:     workload.GetName(),
+		},
+		Status:  status,
+		Created: ptr.To(workload.GetCreationTimestamp()),
+		Manual:  workload.GetAnnotations()[common.AnnotationCronTrigger] != "",
+	}
+	if original, attempt := getRetryOf(workload); attempt > 0 {
+		entry.RetryOf = original
+		entry.RetryAttempt = attempt
+	}
+	return entry
+}
+
+// suspendWorkload suspends the given workload if its kind supports suspension, and reports whether it does.
+func suspendWorkload(workload client.Object) bool {
+	u, ok := workload.(*unstructured.Unstructured)
+	if !ok {
+		return false
+	}
+	field := getSuspendField(u)
+	if field == nil {
+		return false
+	}
+	if err := unstructured.SetNestedField(u.Object, true, field...); err != nil {
+		return false
+	}
+	return true
+}
+
+// getLastScheduleTime returns the last time a job was scheduled by the given schedule.
+func getLastScheduleTime(cron *v1alpha1.Cron, schedule string) *metav1.Time {
+	if schedule == "" {
+		return cron.Status.LastScheduleTime
+	}
+	for _, s := range cron.Status.Schedules {
+		if s.Name == schedule {
+			return s.LastScheduleTime
+		}
+	}
+	return nil
+}
+
+// setLastScheduleTime records the last time a job was scheduled by the given schedule.
+// With multiple schedules, the overall last schedule time is the latest one of all schedules.
+func setLastScheduleTime(cron *v1alpha1.Cron, schedule string, scheduleTime time.Time) {
+	t := ptr.To(metav1.NewTime(scheduleTime))
+	if schedule == "" {
+		cron.Status.LastScheduleTime = t
+		return
+	}
+
+	if cron.Status.LastScheduleTime == nil || cron.Status.LastScheduleTime.Before(t) {
+		cron.Status.LastScheduleTime = t
+	}
+	for i := range cron.Status.Schedules {
+		if cron.Status.Schedules[i].Name == schedule {
+			cron.Status.Schedules[i].LastScheduleTime = t
+			return
+		}
+	}
+	cron.Status.Schedules = append(cron.Status.Schedules, v1alpha1.CronScheduleStatus{
+		Name:             schedule,
+		LastScheduleTime: t,
+	})
+}
+
+// pruneScheduleStatuses removes the status of schedules that no longer exist.
+func pruneScheduleStatuses(cron *v1alpha1.Cron, schedules []cronSchedule) {
+	cron.Status.Schedules = slices.DeleteFunc(cron.Status.Schedules, func(s v1alpha1.CronScheduleStatus) bool {
+		return !slices.ContainsFunc(schedules, func(sched cronSchedule) bool {
+			return sched.name != "" && sched.name == s.Name
+		})
+	})
+	if len(cron.Status.Schedules) == 0 {
+		cron.Status.Schedules = nil
+	}
+}
+
+// pruneQueuedRuns removes the queued runs of schedules that no longer exist, and all queued runs
+// once the concurrency policy is no longer Queue.
+func pruneQueuedRuns(cron *v1alpha1.Cron, schedules []cronSchedule) {
+	cron.Status.QueuedRuns = slices.DeleteFunc(cron.Status.QueuedRuns, func(run v1alpha1.CronQueuedRun) bool {
+		return cron.Spec.ConcurrencyPolicy != v1alpha1.ConcurrentPolicyQueue || !slices.ContainsFunc(schedules, func(sched cronSchedule) bool {
+			return sched.name == run.Schedule
+		})
+	})
+	if len(cron.Status.QueuedRuns) == 0 {
+		cron.Status.QueuedRuns = nil
+	}
+}
+
+// removeQueuedRun removes the run of the given schedule scheduled at the given time from the queued runs.
+func removeQueuedRun(cron *v1alpha1.Cron, schedule string, scheduleTime time.Time) {
+	cron.Status.QueuedRuns = slices.DeleteFunc(cron.Status.QueuedRuns, func(run v1alpha1.CronQueuedRun) bool {
+		return run.Schedule == schedule && run.ScheduleTime.Unix() == scheduleTime.Unix()
+	})
+	if len(cron.Status.QueuedRuns) == 0 {
+		cron.Status.QueuedRuns = nil
+	}
+}
+
+// setSchedulingCondition sets the Scheduling condition of the given Cron.
+func setSchedulingCondition(cron *v1alpha1.Cron, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&cron.Status.Conditions, metav1.Condition{
+		Type:               v1alpha1.CronConditionScheduling,
+		Status:             status,
+		ObservedGeneration: cron.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// setCompleteCondition marks the given Cron as complete, and reports whether it was not complete before.
+func setCompleteCondition(cron *v1alpha1.Cron, reason, message string) bool {
+	complete := meta.IsStatusConditionTrue(cron.Status.Conditions, v1alpha1.CronConditionComplete)
+	meta.SetStatusCondition(&cron.Status.Conditions, metav1.Condition{
+		Type:               v1alpha1.CronConditionComplete,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: cron.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+	return !complete
+}
+
+// filterWorkloadsBySchedule returns the workloads that were created by the given schedule.
+func filterWorkloadsBySchedule(workloads []client.Object, schedule string) []client.Object {
+	if schedule == "" {
+		return workloads
+	}
+
+	filtered := []client.Object{}
+	for _, workload := range workloads {
+		if workload.GetLabels()[common.LabelCronSchedule] == schedule {
+			filtered = append(filtered, workload)
+		}
+	}
+	return filtered
+}
+
+// getAvailableActiveRuns returns the number of runs that can be created for the given active workloads of
+// a schedule before reaching the maximum number of active runs of the given Cron, and whether there is a
+// maximum. The maximum only applies with the Allow concurrency policy. Workloads being deleted are no
+// longer counted as active.
+func getAvailableActiveRuns(cron *v1alpha1.Cron, activeWorkloads []client.Object) (int, bool) {
+	if cron.Spec.MaxActiveRuns == nil {
+		return 0, false
+	}
+	if cron.Spec.ConcurrencyPolicy != "" && cron.Spec.ConcurrencyPolicy != v1alpha1.ConcurrentPolicyAllow {
+		return 0, false
+	}
+
+	available := int(*cron.Spec.MaxActiveRuns)
+	for _, workload := range activeWorkloads {
+		if workload.GetDeletionTimestamp() == nil {
+			available--
+		}
+	}
+	return available, true
+}
+
+// getExcessActiveRuns returns the oldest of the given active workloads that have to be deleted to make
+// room for the given number of new runs within the maximum number of active runs of the given Cron.
+func getExcessActiveRuns(cron *v1alpha1.Cron, activeWorkloads []client.Object, newRuns int) []client.Object {
+	available, ok := getAvailableActiveRuns(cron, activeWorkloads)
+	if !ok || newRuns <= available {
+		return nil
+	}
+
+	active := slices.DeleteFunc(slices.Clone(activeWorkloads), func(workload client.Object) bool {
+		return workload.GetDeletionTimestamp() != nil
+	})
+	sortByCreationTimestamp(active)
+	return active[:min(newRuns-available, len(active))]
+}
+
+// withoutWorkloads returns the given workloads except the excluded ones.
+func withoutWorkloads(workloads, excluded []client.Object) []client.Object {
+	return slices.DeleteFunc(slices.Clone(workloads), func(workload client.Object) bool {
+		return slices.Contains(excluded, workload)
+	})
+}
+
+// keyedMutex is a set of mutexes identified by keys, e.g. one per concurrency group. The zero value is ready to use.
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+// lock locks the mutex of the given key, and returns the function unlocking it.
+func (m *keyedMutex) lock(key string) func() {
+	m.mu.Lock()
+	if m.locks == nil {
+		m.locks = map[string]*sync.Mutex{}
+	}
+	l, ok := m.locks[key]
+	if !ok {
+		l = &sync.Mutex{}
+		m.locks[key] = l
+	}
+	m.mu.Unlock()
+
+	l.Lock()
+	return l.Unlock
+}
+
+// throttledSchedules records when the creation of the missed runs of the schedules of Crons was first
+// throttled by the rate limit, until they are created. The zero value is ready to use.
+type throttledSchedules struct {
+	mu    sync.Mutex
+	since map[types.NamespacedName]map[string]time.Time
+}
+
+// get returns when the creation of the missed runs of the given schedule of the given Cron was first throttled.
+func (t *throttledSchedules) get(cron types.NamespacedName, schedule string) (time.Time, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	since, ok := t.since[cron][schedule]
+	return since, ok
+}
+
+// set records the given time as the first time the creation of the missed runs of the given schedule of the
+// given Cron was throttled unless it has been throttled before, or forgets it if the creation is not throttled.
+func (t *throttledSchedules) set(cron types.NamespacedName, schedule string, throttled bool, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !throttled {
+		delete(t.since[cron], schedule)
+		if len(t.since[cron]) == 0 {
+			delete(t.since, cron)
+		}
+		return
+	}
+
+	if t.since == nil {
+		t.since = map[types.NamespacedName]map[string]time.Time{}
+	}
+	if t.since[cron] == nil {
+		t.since[cron] = map[string]time.Time{}
+	}
+	if _, ok := t.since[cron][schedule]; !ok {
+		t.since[cron][schedule] = now
+	}
+}
+
+// forget forgets the throttled schedules of the given Cron, e.g. once it has been deleted.
+func (t *throttledSchedules) forget(cron types.NamespacedName) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.since, cron)
+}
+
+// concurrencyGroup is the concurrency group of a Cron as observed at the start of a reconciliation.
+type concurrencyGroup struct {
+	// limit is the maximum number of active runs of the Crons in the group.
+	limit int
+	// othersActive is the number of active runs of the other Crons in the group.
+	othersActive int
+}
+
+// available returns the number of runs that can be created within the limit of the group given the active
+// workloads of the Cron. Workloads being deleted are no longer counted as active.
+func (g *concurrencyGroup) available(activeWorkloads []client.Object) int {
+	available := g.limit - g.othersActive
+	for _, workload := range activeWorkloads {
+		if workload.GetDeletionTimestamp() == nil {
+			available--
+		}
+	}
+	return available
+}
+
+// isWorkloadKindNotFound reports whether the given error of a request for workloads means their kind is not served,
+// e.g. because their CRD has been uninstalled since the kind was looked up, in which case listing them is not found.
+func isWorkloadKindNotFound(err error) bool {
+	return meta.IsNoMatchError(err) || apierrors.IsNotFound(err)
+}
+
 // isWorkloadFinished determines if a job has reached a terminal state (Succeeded or Failed)
 // by examining its status conditions.
-func isWorkloadFinished(workload metav1.Object) (kubeflowv1.JobConditionType, bool) {
-	status, err := getJobStatus(workload)
+func isWorkloadFinished(workload metav1.Object, rules *statusrule.Program) (kubeflowv1.JobConditionType, bool) {
+	status, err := getJobStatus(workload, rules)
 	if err != nil {
 		klog.Errorf("failed to extract job status from unstructured object: %v", err)
 		return "", false
@@ -88,14 +630,22 @@ func isWorkloadFinished(workload metav1.Object) (kubeflowv1.JobConditionType, bo
 }
 
 // getJobStatus converts the generic 'status' field from an Unstructured object
-// into a typed kubeflowv1.JobStatus struct for easier manipulation.
-func getJobStatus(workload metav1.Object) (kubeflowv1.JobStatus, error) {
+// into a typed kubeflowv1.JobStatus struct for easier manipulation. The status is
+// interpreted by the given status rules if not nil, and the status of workloads that
+// are not Kubeflow jobs is converted by the status adapter of their kind, or following
+// the kstatus conventions for kinds without one, otherwise.
+func getJobStatus(workload metav1.Object, rules *statusrule.Program) (kubeflowv1.JobStatus, error) {
 	status := kubeflowv1.JobStatus{}
 	u, ok := workload.(*unstructured.Unstructured)
 	if !ok {
 		return status, fmt.Errorf("failed to convert workload to unstructured object")
 	}
 
+	interpreter := getStatusInterpreter(u, rules)
+	if interpreter == v1alpha1.StatusInterpreterStatusRules {
+		return getRuleStatus(rules, u)
+	}
+
 	statusField, ok := u.Object["status"]
 	if !ok {
 		return status, nil
@@ -106,6 +656,13 @@ func getJobStatus(workload metav1.Object) (kubeflowv1.JobStatus, error) {
 		return status, nil
 	}
 
+	switch interpreter {
+	case v1alpha1.StatusInterpreterBuiltIn:
+		return getStatusAdapter(u)(us)
+	case v1alpha1.StatusInterpreterKStatus:
+		return getKStatus(u, us)
+	}
+
 	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(us, &status); err != nil {
 		return status, fmt.Errorf("failed to convert status from unstructured: %v", err)
 	}
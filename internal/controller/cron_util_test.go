@@ -25,14 +25,19 @@ import (
 	. "github.com/onsi/gomega"
 
 	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/AliyunContainerService/cron-operator/api/v1alpha1"
+	"github.com/AliyunContainerService/cron-operator/pkg/common"
 )
 
 var _ = Describe("CronUtil", func() {
@@ -134,6 +139,23 @@ var _ = Describe("CronUtil", func() {
 		})
 	})
 
+	Context("setPodTemplateLabel", func() {
+		It("should set the label on the nested pod templates", func() {
+			obj := map[string]interface{}{}
+			Expect(json.Unmarshal([]byte(`{"apiVersion":"ray.io/v1","kind":"RayJob","spec":{"rayClusterSpec":{
+				"headGroupSpec":{"template":{"metadata":{"labels":{"app":"ray"}},"spec":{"containers":[{"name":"head"}]}}},
+				"workerGroupSpecs":[{"groupName":"gpu","template":{"spec":{"containers":[{"name":"worker"}]}}}]}}}`), &obj)).To(Succeed())
+
+			setPodTemplateLabel(obj, common.LabelCronName, "cron")
+			head, _, _ := unstructured.NestedStringMap(obj, "spec", "rayClusterSpec", "headGroupSpec", "template", "metadata", "labels")
+			Expect(head).To(Equal(map[string]string{"app": "ray", common.LabelCronName: "cron"}))
+			groups, _, _ := unstructured.NestedSlice(obj, "spec", "rayClusterSpec", "workerGroupSpecs")
+			worker, _, _ := unstructured.NestedStringMap(groups[0].(map[string]interface{}), "template", "metadata", "labels")
+			Expect(worker).To(Equal(map[string]string{common.LabelCronName: "cron"}))
+			Expect(obj).NotTo(HaveKey("metadata"))
+		})
+	})
+
 	Context("getDefaultJobName", func() {
 		It("should generate a deterministic name", func() {
 			cron := &v1alpha1.Cron{
@@ -148,6 +170,370 @@ var _ = Describe("CronUtil", func() {
 		})
 	})
 
+	Context("getJitterOffset", func() {
+		It("should return zero without jitter", func() {
+			cron := &v1alpha1.Cron{
+				ObjectMeta: metav1.ObjectMeta{UID: "6b4c8a5e-3f2d-4e1a-9c7b-0d8e2f1a3b5c"},
+			}
+			Expect(getJitterOffset(cron)).To(BeZero())
+		})
+
+		It("should derive a stable offset within the jitter from the UID", func() {
+			cron := &v1alpha1.Cron{
+				ObjectMeta: metav1.ObjectMeta{UID: "6b4c8a5e-3f2d-4e1a-9c7b-0d8e2f1a3b5c"},
+				Spec: v1alpha1.CronSpec{
+					Jitter: &metav1.Duration{Duration: 10 * time.Minute},
+				},
+			}
+			offset := getJitterOffset(cron)
+			Expect(offset).To(Equal(70 * time.Second))
+			Expect(getJitterOffset(cron.DeepCopy())).To(Equal(offset))
+
+			cron.UID = "a1b2c3d4-0000-4000-8000-000000000001"
+			Expect(getJitterOffset(cron)).NotTo(Equal(offset))
+		})
+	})
+
+	Context("getCronSchedules", func() {
+		It("should return the single schedule without name", func() {
+			cron := &v1alpha1.Cron{
+				Spec: v1alpha1.CronSpec{Schedule: "*/1 * * * *"},
+			}
+			Expect(getCronSchedules(cron)).To(Equal([]cronSchedule{{schedule: "*/1 * * * *"}}))
+		})
+
+		It("should identify unnamed schedules by their index", func() {
+			cron := &v1alpha1.Cron{
+				Spec: v1alpha1.CronSpec{
+					Schedules: []v1alpha1.CronSchedule{
+						{Name: "weekdays", Schedule: "0 2 * * 1-5"},
+						{Schedule: "0 6 * * 0,6"},
+					},
+				},
+			}
+			Expect(getCronSchedules(cron)).To(Equal([]cronSchedule{
+				{name: "weekdays", schedule: "0 2 * * 1-5"},
+				{name: "1", schedule: "0 6 * * 0,6"},
+			}))
+		})
+	})
+
+	Context("normalizeSchedules", func() {
+		It("should join the normalized schedules", func() {
+			schedules := []cronSchedule{
+				{name: "a", schedule: "0  2 * * 1-5"},
+				{name: "b", schedule: "@hourly"},
+			}
+			Expect(normalizeSchedules(schedules)).To(Equal("0 2 * * 1-5; 0 * * * *"))
+		})
+	})
+
+	Context("getScheduleJobName", func() {
+		It("should include the schedule name for multiple schedules", func() {
+			cron := &v1alpha1.Cron{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+				},
+			}
+			scheduleTime := time.Unix(1234567890, 0)
+			Expect(getScheduleJobName(cron, "", scheduleTime)).To(Equal(getDefaultJobName(cron, scheduleTime)))
+			Expect(getScheduleJobName(cron, "weekdays", scheduleTime)).To(Equal(fmt.Sprintf("%s-weekdays-%d", name, scheduleTime.Unix())))
+		})
+	})
+
+	Context("getTriggeredJobName", func() {
+		It("should derive a stable name from the token", func() {
+			cron := &v1alpha1.Cron{ObjectMeta: metav1.ObjectMeta{Name: "cron"}}
+			name := getTriggeredJobName(cron, "token-1")
+			Expect(name).To(MatchRegexp(`^cron-manual-[0-9a-f]{8}$`))
+			Expect(getTriggeredJobName(cron, "token-1")).To(Equal(name))
+			Expect(getTriggeredJobName(cron, "token-2")).NotTo(Equal(name))
+		})
+	})
+
+	Context("getRetryOf", func() {
+		It("should return the original run and the number of the retry", func() {
+			w := &unstructured.Unstructured{}
+			w.SetName("cron-1")
+			original, attempt := getRetryOf(w)
+			Expect(original).To(Equal("cron-1"))
+			Expect(attempt).To(BeZero())
+
+			w.SetName(getRetryJobName("cron-1", 2))
+			w.SetAnnotations(map[string]string{
+				common.AnnotationCronRetryOf:      "cron-1",
+				common.AnnotationCronRetryAttempt: "2",
+			})
+			original, attempt = getRetryOf(w)
+			Expect(original).To(Equal("cron-1"))
+			Expect(attempt).To(Equal(int32(2)))
+			Expect(w.GetName()).To(Equal("cron-1-retry-2"))
+		})
+	})
+
+	Context("getRetryBackoff", func() {
+		It("should double the backoff for each retry up to the maximum", func() {
+			policy := &v1alpha1.CronRetryPolicy{MaxRetries: 10}
+			Expect(getRetryBackoff(policy, 1)).To(Equal(defaultRetryBackoff))
+			Expect(getRetryBackoff(policy, 3)).To(Equal(4 * defaultRetryBackoff))
+			Expect(getRetryBackoff(policy, 100)).To(Equal(defaultMaxRetryBackoff))
+
+			policy.Backoff = &metav1.Duration{Duration: time.Minute}
+			policy.MaxBackoff = &metav1.Duration{Duration: 5 * time.Minute}
+			Expect(getRetryBackoff(policy, 2)).To(Equal(2 * time.Minute))
+			Expect(getRetryBackoff(policy, 4)).To(Equal(5 * time.Minute))
+		})
+	})
+
+	Context("suspendWorkload", func() {
+		It("should suspend Kubeflow jobs", func() {
+			w := &unstructured.Unstructured{}
+			w.SetGroupVersionKind(kubeflowv1.SchemeGroupVersion.WithKind("PyTorchJob"))
+			Expect(suspendWorkload(w)).To(BeTrue())
+			suspend, found, err := unstructured.NestedBool(w.Object, "spec", "runPolicy", "suspend")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(suspend).To(BeTrue())
+		})
+
+		DescribeTable("should suspend workloads of adapted kinds with a suspend field",
+			func(gvk schema.GroupVersionKind) {
+				w := &unstructured.Unstructured{}
+				w.SetGroupVersionKind(gvk)
+				Expect(suspendWorkload(w)).To(BeTrue())
+				suspend, found, err := unstructured.NestedBool(w.Object, "spec", "suspend")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(suspend).To(BeTrue())
+			},
+			Entry("batch Job", batchv1.SchemeGroupVersion.WithKind("Job")),
+			Entry("JobSet", jobSetGVK),
+			Entry("TrainJob", trainJobGVK),
+			Entry("RayJob", rayJobGVK),
+		)
+
+		It("should not suspend workloads of kinds that cannot be suspended", func() {
+			w := &unstructured.Unstructured{}
+			w.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Pod"))
+			Expect(suspendWorkload(w)).To(BeFalse())
+
+			w = &unstructured.Unstructured{}
+			w.SetGroupVersionKind(schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Job"})
+			Expect(suspendWorkload(w)).To(BeFalse())
+			Expect(w.Object).NotTo(HaveKey("spec"))
+		})
+	})
+
+	Context("newCronHistory", func() {
+		It("should link a retry to its original run", func() {
+			w := &unstructured.Unstructured{}
+			w.SetGroupVersionKind(kubeflowv1.SchemeGroupVersion.WithKind("PyTorchJob"))
+			w.SetName(getRetryJobName("cron-1", 1))
+			w.SetAnnotations(map[string]string{common.AnnotationCronRetryOf: "cron-1", common.AnnotationCronRetryAttempt: "1"})
+
+			entry := newCronHistory(w, v1alpha1.JobTimedOut)
+			Expect(entry.Object.Kind).To(Equal("PyTorchJob"))
+			Expect(*entry.Object.APIGroup).To(Equal("kubeflow.org/v1"))
+			Expect(entry.Status).To(Equal(v1alpha1.JobTimedOut))
+			Expect(entry.RetryOf).To(Equal("cron-1"))
+			Expect(entry.RetryAttempt).To(Equal(int32(1)))
+		})
+	})
+
+	Context("getFailedRuns", func() {
+		newRun := func(name string, created time.Time, status kubeflowv1.JobConditionType) *unstructured.Unstructured {
+			w := &unstructured.Unstructured{Object: map[string]interface{}{
+				"status": map[string]interface{}{
+					"conditions": []interface{}{map[string]interface{}{"type": string(status), "status": "True"}},
+				},
+			}}
+			w.SetName(name)
+			w.SetCreationTimestamp(metav1.NewTime(created))
+			return w
+		}
+		now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		It("should return nothing without a retry policy", func() {
+			cron := &v1alpha1.Cron{}
+			workloads := []client.Object{newRun("cron-1", now, kubeflowv1.JobFailed)}
+			Expect(getFailedRuns(cron, workloads, nil)).To(BeEmpty())
+		})
+
+		It("should return the latest failed attempt of each run with retries left", func() {
+			cron := &v1alpha1.Cron{Spec: v1alpha1.CronSpec{RetryPolicy: &v1alpha1.CronRetryPolicy{MaxRetries: 2}}}
+			retry := newRun(getRetryJobName("cron-1", 1), now.Add(time.Minute), kubeflowv1.JobFailed)
+			retry.SetAnnotations(map[string]string{common.AnnotationCronRetryOf: "cron-1", common.AnnotationCronRetryAttempt: "1"})
+			workloads := []client.Object{newRun("cron-1", now, kubeflowv1.JobFailed), retry}
+
+			failedRuns := getFailedRuns(cron, workloads, nil)
+			Expect(failedRuns).To(HaveLen(1))
+			Expect(failedRuns[0].workload).To(Equal(retry))
+			Expect(failedRuns[0].original).To(Equal("cron-1"))
+			Expect(failedRuns[0].attempt).To(Equal(int32(1)))
+
+			// All retries have failed.
+			cron.Spec.RetryPolicy.MaxRetries = 1
+			Expect(getFailedRuns(cron, workloads, nil)).To(BeEmpty())
+		})
+
+		It("should not return runs superseded by a later run", func() {
+			cron := &v1alpha1.Cron{Spec: v1alpha1.CronSpec{RetryPolicy: &v1alpha1.CronRetryPolicy{MaxRetries: 2}}}
+			workloads := []client.Object{
+				newRun("cron-1", now, kubeflowv1.JobFailed),
+				newRun("cron-2", now.Add(time.Hour), kubeflowv1.JobSucceeded),
+			}
+			Expect(getFailedRuns(cron, workloads, nil)).To(BeEmpty())
+		})
+	})
+
+	Context("setLastScheduleTime", func() {
+		It("should track the last schedule time per schedule", func() {
+			cron := &v1alpha1.Cron{}
+			t1 := time.Date(2026, 1, 1, 2, 0, 0, 0, time.UTC)
+			t2 := time.Date(2026, 1, 1, 6, 0, 0, 0, time.UTC)
+
+			setLastScheduleTime(cron, "b", t2)
+			setLastScheduleTime(cron, "a", t1)
+			Expect(getLastScheduleTime(cron, "a").Time).To(BeTemporally("==", t1))
+			Expect(getLastScheduleTime(cron, "b").Time).To(BeTemporally("==", t2))
+			Expect(getLastScheduleTime(cron, "c")).To(BeNil())
+			Expect(cron.Status.LastScheduleTime.Time).To(BeTemporally("==", t2))
+
+			pruneScheduleStatuses(cron, []cronSchedule{{name: "a"}})
+			Expect(cron.Status.Schedules).To(HaveLen(1))
+			Expect(cron.Status.Schedules[0].Name).To(Equal("a"))
+		})
+	})
+
+	Context("pruneQueuedRuns", func() {
+		It("should remove the queued runs of removed schedules or once the policy is not Queue", func() {
+			t := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+			cron := &v1alpha1.Cron{
+				Spec: v1alpha1.CronSpec{ConcurrencyPolicy: v1alpha1.ConcurrentPolicyQueue},
+				Status: v1alpha1.CronStatus{QueuedRuns: []v1alpha1.CronQueuedRun{
+					{ScheduleTime: metav1.NewTime(t), Schedule: "a"},
+					{ScheduleTime: metav1.NewTime(t), Schedule: "b"},
+					{ScheduleTime: metav1.NewTime(t.Add(time.Hour)), Schedule: "a"},
+				}},
+			}
+
+			pruneQueuedRuns(cron, []cronSchedule{{name: "a"}})
+			Expect(cron.Status.QueuedRuns).To(HaveLen(2))
+
+			removeQueuedRun(cron, "a", t)
+			Expect(cron.Status.QueuedRuns).To(HaveExactElements(HaveField("ScheduleTime.Time", BeTemporally("==", t.Add(time.Hour)))))
+
+			cron.Spec.ConcurrencyPolicy = v1alpha1.ConcurrentPolicyForbid
+			pruneQueuedRuns(cron, []cronSchedule{{name: "a"}})
+			Expect(cron.Status.QueuedRuns).To(BeNil())
+		})
+	})
+
+	Context("setCompleteCondition", func() {
+		It("should report whether the cron was not complete before", func() {
+			cron := &v1alpha1.Cron{}
+			Expect(setCompleteCondition(cron, v1alpha1.CronReasonMaxRunsReached, "done")).To(BeTrue())
+			Expect(setCompleteCondition(cron, v1alpha1.CronReasonMaxRunsReached, "done")).To(BeFalse())
+			Expect(meta.IsStatusConditionTrue(cron.Status.Conditions, v1alpha1.CronConditionComplete)).To(BeTrue())
+		})
+	})
+
+	Context("filterWorkloadsBySchedule", func() {
+		It("should return the workloads created by the schedule", func() {
+			w1 := &unstructured.Unstructured{}
+			w1.SetLabels(map[string]string{common.LabelCronSchedule: "a"})
+			w2 := &unstructured.Unstructured{}
+			w2.SetLabels(map[string]string{common.LabelCronSchedule: "b"})
+
+			workloads := []client.Object{w1, w2}
+			Expect(filterWorkloadsBySchedule(workloads, "a")).To(Equal([]client.Object{w1}))
+			Expect(filterWorkloadsBySchedule(workloads, "")).To(Equal(workloads))
+		})
+	})
+
+	Context("getExcessActiveRuns", func() {
+		newActive := func(name string, created time.Time) *unstructured.Unstructured {
+			w := &unstructured.Unstructured{}
+			w.SetName(name)
+			w.SetCreationTimestamp(metav1.NewTime(created))
+			return w
+		}
+		now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		It("should return nothing without max active runs or with a concurrency policy other than Allow", func() {
+			cron := &v1alpha1.Cron{}
+			workloads := []client.Object{newActive("cron-1", now)}
+			Expect(getExcessActiveRuns(cron, workloads, 1)).To(BeEmpty())
+
+			cron.Spec.MaxActiveRuns = ptr.To[int32](1)
+			cron.Spec.ConcurrencyPolicy = v1alpha1.ConcurrentPolicyForbid
+			Expect(getExcessActiveRuns(cron, workloads, 1)).To(BeEmpty())
+		})
+
+		It("should return the oldest active runs beyond the maximum", func() {
+			cron := &v1alpha1.Cron{Spec: v1alpha1.CronSpec{MaxActiveRuns: ptr.To[int32](3)}}
+			workloads := []client.Object{
+				newActive("cron-2", now.Add(time.Minute)),
+				newActive("cron-1", now),
+				newActive("cron-3", now.Add(2*time.Minute)),
+			}
+
+			available, ok := getAvailableActiveRuns(cron, workloads)
+			Expect(ok).To(BeTrue())
+			Expect(available).To(BeZero())
+			Expect(getExcessActiveRuns(cron, workloads, 0)).To(BeEmpty())
+			Expect(getExcessActiveRuns(cron, workloads, 2)).To(HaveExactElements(workloads[1], workloads[0]))
+		})
+
+		It("should not count active runs being deleted", func() {
+			cron := &v1alpha1.Cron{Spec: v1alpha1.CronSpec{MaxActiveRuns: ptr.To[int32](1)}}
+			deleting := newActive("cron-1", now)
+			deleting.SetDeletionTimestamp(ptr.To(metav1.NewTime(now)))
+			workloads := []client.Object{deleting, newActive("cron-2", now.Add(time.Minute))}
+
+			Expect(getExcessActiveRuns(cron, workloads, 1)).To(HaveExactElements(workloads[1]))
+		})
+	})
+
+	Context("keyedMutex", func() {
+		It("should only serialize the holders of the same key", func() {
+			m := &keyedMutex{}
+			unlock := m.lock("default/shard-1")
+
+			// Another key is not held.
+			m.lock("default/shard-2")()
+
+			locked := make(chan struct{})
+			go func() {
+				defer GinkgoRecover()
+				m.lock("default/shard-1")()
+				close(locked)
+			}()
+			Consistently(locked, 100*time.Millisecond).ShouldNot(BeClosed())
+			unlock()
+			Eventually(locked).Should(BeClosed())
+		})
+	})
+
+	Context("concurrencyGroup", func() {
+		It("should return the runs available within the limit of the group", func() {
+			now := metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+			active := &unstructured.Unstructured{}
+			active.SetName("cron-1")
+			deleting := &unstructured.Unstructured{}
+			deleting.SetName("cron-2")
+			deleting.SetDeletionTimestamp(&now)
+
+			group := &concurrencyGroup{limit: 3, othersActive: 1}
+			Expect(group.available(nil)).To(Equal(2))
+			Expect(group.available([]client.Object{active, deleting})).To(Equal(1))
+
+			group.othersActive = 3
+			Expect(group.available([]client.Object{active})).To(Equal(-1))
+		})
+	})
+
 	Context("getJobStatus", func() {
 		It("should extract status from unstructured object", func() {
 			status := kubeflowv1.JobStatus{
@@ -168,13 +554,31 @@ var _ = Describe("CronUtil", func() {
 				},
 			}
 
-			s, err := getJobStatus(u)
+			s, err := getJobStatus(u, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(s.Conditions).To(HaveLen(1))
 			Expect(s.Conditions[0].Type).To(Equal(kubeflowv1.JobSucceeded))
 		})
 	})
 
+	Context("isWorkloadKindNotFound", func() {
+		It("should return true when the kind is not served", func() {
+			err := &meta.NoKindMatchError{GroupKind: schema.GroupKind{Group: "example.com", Kind: "Training"}}
+			Expect(isWorkloadKindNotFound(err)).To(BeTrue())
+			Expect(isWorkloadKindNotFound(fmt.Errorf("list workloads: %w", err))).To(BeTrue())
+		})
+
+		It("should return true when the resource is not found", func() {
+			err := apierrors.NewNotFound(schema.GroupResource{Group: "example.com", Resource: "trainings"}, "")
+			Expect(isWorkloadKindNotFound(err)).To(BeTrue())
+		})
+
+		It("should return false for other errors", func() {
+			Expect(isWorkloadKindNotFound(nil)).To(BeFalse())
+			Expect(isWorkloadKindNotFound(fmt.Errorf("connection refused"))).To(BeFalse())
+		})
+	})
+
 	Context("isWorkloadFinished", func() {
 		It("should return true for succeeded job", func() {
 			u := &unstructured.Unstructured{
@@ -189,7 +593,7 @@ var _ = Describe("CronUtil", func() {
 					},
 				},
 			}
-			cond, finished := isWorkloadFinished(u)
+			cond, finished := isWorkloadFinished(u, nil)
 			Expect(finished).To(BeTrue())
 			Expect(cond).To(Equal(kubeflowv1.JobSucceeded))
 		})
@@ -207,7 +611,7 @@ var _ = Describe("CronUtil", func() {
 					},
 				},
 			}
-			cond, finished := isWorkloadFinished(u)
+			cond, finished := isWorkloadFinished(u, nil)
 			Expect(finished).To(BeTrue())
 			Expect(cond).To(Equal(kubeflowv1.JobFailed))
 		})
@@ -225,7 +629,7 @@ var _ = Describe("CronUtil", func() {
 					},
 				},
 			}
-			_, finished := isWorkloadFinished(u)
+			_, finished := isWorkloadFinished(u, nil)
 			Expect(finished).To(BeFalse())
 		})
 	})
@@ -0,0 +1,388 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	kubeflowutil "github.com/kubeflow/training-operator/pkg/util"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/AliyunContainerService/cron-operator/api/v1alpha1"
+	"github.com/AliyunContainerService/cron-operator/internal/ratelimit"
+	"github.com/AliyunContainerService/cron-operator/internal/schedule"
+	"github.com/AliyunContainerService/cron-operator/internal/statusrule"
+	"github.com/AliyunContainerService/cron-operator/pkg/common"
+)
+
+const (
+	// maxBackfillScheduleTimes is the maximum number of scheduled times within the time range of a backfill.
+	maxBackfillScheduleTimes = 10000
+
+	// backfillConflictRetryInterval is how long to wait before backfilling a scheduled time again whose
+	// workload name is taken by a workload that is not controlled by the backfill.
+	backfillConflictRetryInterval = 10 * time.Second
+)
+
+// errTooManyScheduleTimes is returned when the time range of a backfill contains too many scheduled times.
+var errTooManyScheduleTimes = errors.New("too many scheduled times")
+
+// CronBackfillReconciler reconciles a CronBackfill object.
+type CronBackfillReconciler struct {
+	scheme   *runtime.Scheme
+	client   client.Client
+	reader   client.Reader
+	recorder record.EventRecorder
+	limiter  *ratelimit.Limiter
+	// statusRules are the status rules of workload kinds in the operator configuration.
+	statusRules *statusrule.Set
+	// watcher watches the workloads of kinds seen in templates that are not watched at startup.
+	watcher *workloadWatcher
+}
+
+// NewCronBackfillReconciler creates a new CronBackfillReconciler.
+// The limiter bounds the rate of workload creations, and may be nil to create workloads without limit.
+// The status rules interpret the status of workloads of the configured kinds, and may be nil if there are none.
+func NewCronBackfillReconciler(s *runtime.Scheme, c client.Client, r client.Reader, recorder record.EventRecorder, limiter *ratelimit.Limiter, statusRules *statusrule.Set) *CronBackfillReconciler {
+	return &CronBackfillReconciler{
+		scheme:      s,
+		client:      c,
+		reader:      r,
+		recorder:    recorder,
+		limiter:     limiter,
+		statusRules: statusRules,
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *CronBackfillReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	b, watched, err := ownWorkloads(mgr, ctrl.NewControllerManagedBy(mgr).For(&v1alpha1.CronBackfill{}))
+	if err != nil {
+		return err
+	}
+	c, err := b.
+		WithLogConstructor(logConstructor(mgr.GetLogger(), "cronbackfill")).
+		Build(r)
+	if err != nil {
+		return err
+	}
+	r.watcher = newWorkloadWatcher(mgr, c, &v1alpha1.CronBackfill{}, watched)
+	return nil
+}
+
+// +kubebuilder:rbac:groups=apps.kubedl.io,resources=cronbackfills,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=apps.kubedl.io,resources=cronbackfills/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=apps.kubedl.io,resources=cronbackfills/finalizers,verbs=update
+// +kubebuilder:rbac:groups=apps.kubedl.io,resources=crons,verbs=get;list;watch
+
+// Reconcile creates one workload from the template of the referenced Cron for each scheduled time
+// of each of its schedules within the time range of the backfill, oldest first, with at most the
+// parallelism of the backfill active at the same time.
+func (r *CronBackfillReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, reconcileErr error) {
+	log := logf.FromContext(ctx)
+	log.Info("Start reconciling CronBackfill")
+	defer log.Info("Finish reconciling CronBackfill")
+
+	oldBackfill := &v1alpha1.CronBackfill{}
+	if err := r.client.Get(ctx, req.NamespacedName, oldBackfill); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("Skip reconciling CronBackfill for it may have been deleted")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+	backfill := oldBackfill.DeepCopy()
+
+	defer func() {
+		if apiequality.Semantic.DeepEqual(oldBackfill.Status, backfill.Status) {
+			return
+		}
+
+		if err := r.client.Status().Patch(ctx, backfill, client.MergeFrom(oldBackfill)); err != nil {
+			reconcileErr = errors.Join(reconcileErr, fmt.Errorf("failed to patch CronBackfill status: %w", err))
+		}
+	}()
+
+	if backfill.DeletionTimestamp != nil {
+		log.Info("CronBackfill has been deleted", "deletionTimestamp", backfill.DeletionTimestamp)
+		return ctrl.Result{}, nil
+	}
+
+	// Get the Cron whose schedules and template are backfilled.
+	cron := &v1alpha1.Cron{}
+	if err := r.client.Get(ctx, client.ObjectKey{Namespace: backfill.Namespace, Name: backfill.Spec.CronRef.Name}, cron); err != nil {
+		log.Error(err, "Failed to get Cron", "cron", backfill.Spec.CronRef.Name)
+		r.recorder.Eventf(backfill, corev1.EventTypeWarning, "FailedGetCron", "Error getting Cron %s: %v", backfill.Spec.CronRef.Name, err)
+		return ctrl.Result{}, err
+	}
+
+	runs, err := getBackfillRuns(cron, backfill.Spec.From.Time, backfill.Spec.To.Time)
+	if err != nil {
+		reason := v1alpha1.CronBackfillReasonInvalidSchedule
+		if errors.Is(err, errTooManyScheduleTimes) {
+			reason = v1alpha1.CronBackfillReasonTooManyScheduleTimes
+		}
+		log.Error(err, "Failed to get scheduled times to backfill")
+		r.setFailed(backfill, reason, err.Error())
+		return ctrl.Result{}, nil
+	}
+	backfill.Status.Total = int32(len(runs))
+	meta.RemoveStatusCondition(&backfill.Status.Conditions, v1alpha1.CronBackfillConditionFailed)
+
+	// Runs are backfilled in order, so the ones after the last scheduled run are still pending.
+	pending := runs
+	if lastScheduleTime := backfill.Status.LastScheduleTime; lastScheduleTime != nil {
+		schedules := getCronSchedules(cron)
+		// Runs of a schedule that has been removed since are considered backfilled for their scheduled time.
+		last := slices.IndexFunc(schedules, func(sched cronSchedule) bool {
+			return sched.name == backfill.Status.LastSchedule
+		})
+		if last < 0 {
+			last = len(schedules)
+		}
+		i := slices.IndexFunc(runs, func(run backfillRun) bool {
+			if !run.scheduleTime.Equal(lastScheduleTime.Time) {
+				return run.scheduleTime.After(lastScheduleTime.Time)
+			}
+			return run.index > last
+		})
+		if i < 0 {
+			i = len(runs)
+		}
+		pending = runs[i:]
+	}
+
+	// The backfill does not watch the Cron, so it is retried until the template of the Cron is fixed.
+	gvk, err := getWorkloadGVK(cron)
+	if err != nil {
+		log.Error(err, "Failed to get workload GVK")
+		return ctrl.Result{}, err
+	}
+	rules, err := r.statusRules.Get(gvk.GroupKind(), cron.Spec.Template.StatusRules)
+	if err != nil {
+		log.Error(err, "Failed to compile workload status rules")
+		r.recorder.Eventf(backfill, corev1.EventTypeWarning, "InvalidStatusRules", "Error compiling status rules: %v", err)
+		return ctrl.Result{}, err
+	}
+	if err := r.watcher.watch(ctx, gvk); err != nil {
+		log.Error(err, "Failed to watch backfilled workloads")
+		return ctrl.Result{}, err
+	}
+
+	workloads, err := r.listWorkloads(ctx, cron, backfill)
+	if err != nil {
+		log.Error(err, "Failed to list backfilled workloads")
+		return ctrl.Result{}, err
+	}
+
+	var active, succeeded, failed int32
+	for _, workload := range workloads {
+		status, err := getJobStatus(workload, rules)
+		if err != nil {
+			// Workloads whose status cannot be interpreted may still be running, so they are counted as active.
+			log.Error(err, "Failed to get workload status", "workload", klog.KObj(workload))
+			r.recorder.Eventf(backfill, corev1.EventTypeWarning, "FailedGetStatus", "Error getting status of %s %s, which is considered active: %v", gvk.Kind, workload.GetName(), err)
+			active++
+			continue
+		}
+
+		switch {
+		case kubeflowutil.IsSucceeded(status):
+			succeeded++
+		case kubeflowutil.IsFailed(status):
+			failed++
+		default:
+			active++
+		}
+	}
+
+	// Create workloads for the pending scheduled times up to the parallelism.
+	parallelism := ptr.Deref(backfill.Spec.Parallelism, 1)
+	for len(pending) > 0 && active < parallelism {
+		run := pending[0]
+		// The run is backfilled once the rate limit allows.
+		reservation, throttled := r.limiter.Reserve(backfill.Namespace)
+		if throttled > 0 {
+			log.Info("Backfilling is throttled", "scheduled", run.scheduleTime, "retryAfter", throttled)
+			result.RequeueAfter = throttled
+			break
+		}
+		created, err := r.createWorkload(ctx, cron, backfill, run, reservation)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		// Scheduled times are backfilled in order, so the backfill waits for the conflicting workload to be deleted.
+		if !created {
+			result.RequeueAfter = backfillConflictRetryInterval
+			break
+		}
+		active++
+		backfill.Status.LastScheduleTime = &metav1.Time{Time: run.scheduleTime}
+		backfill.Status.LastSchedule = run.schedule
+		pending = pending[1:]
+	}
+
+	backfill.Status.Created = backfill.Status.Total - int32(len(pending))
+	backfill.Status.Active = active
+	backfill.Status.Succeeded = succeeded
+	backfill.Status.Failed = failed
+
+	if len(pending) == 0 && active == 0 && backfill.Status.CompletionTime == nil {
+		log.Info("CronBackfill has completed", "succeeded", succeeded, "failed", failed)
+		backfill.Status.CompletionTime = ptr.To(metav1.Now())
+		message := fmt.Sprintf("backfilled %d scheduled times, %d workloads succeeded and %d failed", backfill.Status.Total, succeeded, failed)
+		meta.SetStatusCondition(&backfill.Status.Conditions, metav1.Condition{
+			Type:               v1alpha1.CronBackfillConditionComplete,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: backfill.Generation,
+			Reason:             v1alpha1.CronBackfillReasonCompleted,
+			Message:            message,
+		})
+		r.recorder.Event(backfill, corev1.EventTypeNormal, v1alpha1.CronBackfillReasonCompleted, message)
+	}
+
+	return result, nil
+}
+
+// createWorkload creates the workload of the backfill for the given run. It reports false if a workload that
+// is not controlled by the backfill has the same name, in which case the backfill is marked as failed until it
+// has been deleted. The given reservation of the rate limit is canceled unless the workload is created.
+func (r *CronBackfillReconciler) createWorkload(ctx context.Context, cron *v1alpha1.Cron, backfill *v1alpha1.CronBackfill, run backfillRun, reservation *ratelimit.Reservation) (bool, error) {
+	log := logf.FromContext(ctx)
+
+	workload, err := newWorkload(r.scheme, r.recorder, cron, backfill, run.schedule, getBackfillJobName(cron, backfill, run.schedule, run.scheduleTime))
+	if err != nil {
+		reservation.Cancel()
+		r.setFailed(backfill, v1alpha1.CronBackfillReasonInvalidTemplate, err.Error())
+		return false, fmt.Errorf("unable to initialize workload from cron template: %v", err)
+	}
+	labels := workload.GetLabels()
+	labels[common.LabelCronBackfillName] = backfill.Name
+	workload.SetLabels(labels)
+	setAnnotation(workload, common.AnnotationCronScheduleTime, run.scheduleTime.Format(time.RFC3339))
+
+	gvk := workload.GetObjectKind().GroupVersionKind()
+	objectRef := klog.KRef(workload.GetNamespace(), workload.GetName())
+	log.Info(fmt.Sprintf("Creating %s", gvk.Kind), gvk.Kind, objectRef, "scheduled", run.scheduleTime)
+	if err := r.client.Create(ctx, workload); err != nil {
+		reservation.Cancel()
+		if !apierrors.IsAlreadyExists(err) {
+			r.recorder.Eventf(backfill, corev1.EventTypeWarning, "FailedCreate", "Error creating %s: %v", gvk.Kind, err)
+			return false, err
+		}
+
+		// The workload may have been created by the backfill without its status being updated. The conflicting
+		// workload is read from the API server, as it may not be cached without the Cron name label.
+		existing := &metav1.PartialObjectMetadata{}
+		existing.SetGroupVersionKind(gvk)
+		if err := r.reader.Get(ctx, client.ObjectKeyFromObject(workload), existing); err != nil {
+			return false, err
+		}
+		if !metav1.IsControlledBy(existing, backfill) {
+			log.Info(fmt.Sprintf("Waiting for conflicting %s to be deleted", gvk.Kind), gvk.Kind, objectRef)
+			r.setFailed(backfill, v1alpha1.CronBackfillReasonNameConflict, fmt.Sprintf("%s %s of scheduled time %s is not controlled by CronBackfill %s",
+				gvk.Kind, existing.GetName(), run.scheduleTime.Format(time.RFC3339), backfill.Name))
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// listWorkloads lists the workloads created by the given backfill.
+func (r *CronBackfillReconciler) listWorkloads(ctx context.Context, cron *v1alpha1.Cron, backfill *v1alpha1.CronBackfill) ([]client.Object, error) {
+	gvk, err := getWorkloadGVK(cron)
+	if err != nil {
+		return nil, err
+	}
+
+	uList := unstructured.UnstructuredList{}
+	uList.SetGroupVersionKind(gvk)
+	if err := r.client.List(ctx, &uList, client.InNamespace(backfill.Namespace), client.MatchingLabels{common.LabelCronBackfillName: backfill.Name}); err != nil {
+		return nil, err
+	}
+
+	workloads := make([]client.Object, 0, len(uList.Items))
+	for _, u := range uList.Items {
+		if metav1.IsControlledBy(&u, backfill) {
+			workloads = append(workloads, &u)
+		}
+	}
+	return workloads, nil
+}
+
+// setFailed marks the given backfill as failed.
+func (r *CronBackfillReconciler) setFailed(backfill *v1alpha1.CronBackfill, reason, message string) {
+	if meta.SetStatusCondition(&backfill.Status.Conditions, metav1.Condition{
+		Type:               v1alpha1.CronBackfillConditionFailed,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: backfill.Generation,
+		Reason:             reason,
+		Message:            message,
+	}) {
+		r.recorder.Event(backfill, corev1.EventTypeWarning, reason, message)
+	}
+}
+
+// backfillRun is a run of a schedule of a Cron to backfill.
+type backfillRun struct {
+	// schedule is the name of the schedule, empty if the Cron has a single one.
+	schedule string
+	// index is the index of the schedule in the schedules of the Cron.
+	index        int
+	scheduleTime time.Time
+}
+
+// getBackfillRuns returns the runs of all schedules of the given Cron within [from, to) in ascending
+// order of their scheduled times, and in the order of the schedules for the same scheduled time.
+func getBackfillRuns(cron *v1alpha1.Cron, from, to time.Time) ([]backfillRun, error) {
+	var runs []backfillRun
+	for i, cronSched := range getCronSchedules(cron) {
+		sched, err := schedule.Parse(cronSched.schedule, cron.Spec.ScheduleFormat, cron.Spec.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("unparsable cron %q: %w", cronSched.schedule, err)
+		}
+
+		// Scheduled times are looked for strictly after the given time, so start just before from.
+		for t := sched.Next(from.Add(-time.Nanosecond)); !t.IsZero() && t.Before(to); t = sched.Next(t) {
+			if len(runs) >= maxBackfillScheduleTimes {
+				return nil, fmt.Errorf("%w: more than %d within the time range", errTooManyScheduleTimes, maxBackfillScheduleTimes)
+			}
+			runs = append(runs, backfillRun{schedule: cronSched.name, index: i, scheduleTime: t})
+		}
+	}
+
+	slices.SortStableFunc(runs, func(a, b backfillRun) int {
+		return a.scheduleTime.Compare(b.scheduleTime)
+	})
+	return runs, nil
+}
@@ -0,0 +1,308 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/AliyunContainerService/cron-operator/api/v1alpha1"
+	"github.com/AliyunContainerService/cron-operator/pkg/common"
+)
+
+var _ = Describe("CronBackfill Controller", func() {
+	const (
+		cronName  = "cron-backfill-test"
+		name      = "backfill-test"
+		namespace = "default"
+	)
+
+	ctx := context.Background()
+	key := types.NamespacedName{Namespace: namespace, Name: name}
+	req := reconcile.Request{NamespacedName: key}
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	Context("When reconciling a resource", func() {
+		BeforeEach(func() {
+			cron := &v1alpha1.Cron{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      cronName,
+					Namespace: namespace,
+				},
+				Spec: v1alpha1.CronSpec{
+					Schedule: "*/1 * * * *",
+					Suspend:  ptr.To(true),
+					Template: v1alpha1.CronTemplateSpec{
+						Workload: &runtime.RawExtension{
+							Raw: []byte(`{"apiVersion":"kubeflow.org/v1","kind":"PyTorchJob","spec":{"pytorchReplicaSpecs":{"Master":{"template":{"spec":{"containers":[{"name":"pytorch","image":"pytorch"}]}}}}}}`),
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, cron)).To(Succeed())
+
+			backfill := &v1alpha1.CronBackfill{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+				},
+				Spec: v1alpha1.CronBackfillSpec{
+					CronRef:     corev1.LocalObjectReference{Name: cronName},
+					From:        metav1.Time{Time: from},
+					To:          metav1.Time{Time: from.Add(3 * time.Minute)},
+					Parallelism: ptr.To[int32](2),
+				},
+			}
+			Expect(k8sClient.Create(ctx, backfill)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			backfill := &v1alpha1.CronBackfill{}
+			if err := k8sClient.Get(ctx, key, backfill); err == nil {
+				Expect(k8sClient.Delete(ctx, backfill)).To(Succeed())
+			}
+			cron := &v1alpha1.Cron{}
+			if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: cronName}, cron); err == nil {
+				Expect(k8sClient.Delete(ctx, cron)).To(Succeed())
+			}
+
+			uList := &unstructured.UnstructuredList{}
+			uList.SetGroupVersionKind(kubeflowv1.SchemeGroupVersion.WithKind("PyTorchJob"))
+			Expect(k8sClient.List(ctx, uList, client.InNamespace(namespace))).To(Succeed())
+			for _, item := range uList.Items {
+				Expect(k8sClient.Delete(ctx, &item)).To(Succeed())
+			}
+		})
+
+		It("should backfill each scheduled time within the parallelism", func() {
+			r := NewCronBackfillReconciler(scheme, k8sClient, k8sClient, record.NewFakeRecorder(10), nil, nil)
+			cron := &v1alpha1.Cron{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: cronName}, cron)).To(Succeed())
+			backfill := &v1alpha1.CronBackfill{}
+			Expect(k8sClient.Get(ctx, key, backfill)).To(Succeed())
+
+			listNames := func() []string {
+				uList := &unstructured.UnstructuredList{}
+				uList.SetGroupVersionKind(kubeflowv1.SchemeGroupVersion.WithKind("PyTorchJob"))
+				Expect(k8sClient.List(ctx, uList, client.InNamespace(namespace), client.MatchingLabels{common.LabelCronBackfillName: name})).To(Succeed())
+				names := []string{}
+				for _, item := range uList.Items {
+					names = append(names, item.GetName())
+				}
+				return names
+			}
+
+			_, err := r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(listNames()).To(ConsistOf(getBackfillJobName(cron, backfill, "", from), getBackfillJobName(cron, backfill, "", from.Add(time.Minute))))
+
+			Expect(k8sClient.Get(ctx, key, backfill)).To(Succeed())
+			Expect(backfill.Status.Total).To(Equal(int32(3)))
+			Expect(backfill.Status.Created).To(Equal(int32(2)))
+			Expect(backfill.Status.Active).To(Equal(int32(2)))
+
+			// Mark the first workload as succeeded to make room for the last scheduled time.
+			workload := &unstructured.Unstructured{}
+			workload.SetGroupVersionKind(kubeflowv1.SchemeGroupVersion.WithKind("PyTorchJob"))
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: getBackfillJobName(cron, backfill, "", from)}, workload)).To(Succeed())
+			Expect(workload.GetAnnotations()).To(HaveKeyWithValue(common.AnnotationCronScheduleTime, from.Format(time.RFC3339)))
+			workload.Object["status"] = map[string]interface{}{
+				"conditions":      []interface{}{map[string]interface{}{"type": string(kubeflowv1.JobSucceeded), "status": string(corev1.ConditionTrue)}},
+				"replicaStatuses": map[string]interface{}{},
+			}
+			Expect(k8sClient.Status().Update(ctx, workload)).To(Succeed())
+
+			_, err = r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(listNames()).To(HaveLen(3))
+
+			Expect(k8sClient.Get(ctx, key, backfill)).To(Succeed())
+			Expect(backfill.Status.Created).To(Equal(int32(3)))
+			Expect(backfill.Status.Active).To(Equal(int32(2)))
+			Expect(backfill.Status.Succeeded).To(Equal(int32(1)))
+			Expect(meta.IsStatusConditionTrue(backfill.Status.Conditions, v1alpha1.CronBackfillConditionComplete)).To(BeFalse())
+		})
+
+		It("should backfill each schedule of the Cron", func() {
+			r := NewCronBackfillReconciler(scheme, k8sClient, k8sClient, record.NewFakeRecorder(10), nil, nil)
+			cron := &v1alpha1.Cron{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: cronName}, cron)).To(Succeed())
+			backfill := &v1alpha1.CronBackfill{}
+			Expect(k8sClient.Get(ctx, key, backfill)).To(Succeed())
+			cron.Spec.Schedule = ""
+			cron.Spec.Schedules = []v1alpha1.CronSchedule{
+				{Name: "minutely", Schedule: "*/1 * * * *"},
+				{Name: "even", Schedule: "*/2 * * * *"},
+			}
+			Expect(k8sClient.Update(ctx, cron)).To(Succeed())
+
+			listNames := func() []string {
+				uList := &unstructured.UnstructuredList{}
+				uList.SetGroupVersionKind(kubeflowv1.SchemeGroupVersion.WithKind("PyTorchJob"))
+				Expect(k8sClient.List(ctx, uList, client.InNamespace(namespace), client.MatchingLabels{common.LabelCronBackfillName: name})).To(Succeed())
+				names := []string{}
+				for _, item := range uList.Items {
+					names = append(names, item.GetName())
+				}
+				return names
+			}
+
+			// Both schedules run at the first scheduled time, and are backfilled like the Cron names their runs.
+			_, err := r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(listNames()).To(ConsistOf(getBackfillJobName(cron, backfill, "minutely", from), getBackfillJobName(cron, backfill, "even", from)))
+
+			Expect(k8sClient.Get(ctx, key, backfill)).To(Succeed())
+			Expect(backfill.Status.Total).To(Equal(int32(5)))
+			Expect(backfill.Status.Created).To(Equal(int32(2)))
+			Expect(backfill.Status.LastSchedule).To(Equal("even"))
+
+			// The next run is the one of the first schedule at the next scheduled time.
+			workload := &unstructured.Unstructured{}
+			workload.SetGroupVersionKind(kubeflowv1.SchemeGroupVersion.WithKind("PyTorchJob"))
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: getBackfillJobName(cron, backfill, "minutely", from)}, workload)).To(Succeed())
+			Expect(workload.GetLabels()).To(HaveKeyWithValue(common.LabelCronSchedule, "minutely"))
+			workload.Object["status"] = map[string]interface{}{
+				"conditions":      []interface{}{map[string]interface{}{"type": string(kubeflowv1.JobSucceeded), "status": string(corev1.ConditionTrue)}},
+				"replicaStatuses": map[string]interface{}{},
+			}
+			Expect(k8sClient.Status().Update(ctx, workload)).To(Succeed())
+
+			_, err = r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(listNames()).To(ContainElement(getBackfillJobName(cron, backfill, "minutely", from.Add(time.Minute))))
+			Expect(k8sClient.Get(ctx, key, backfill)).To(Succeed())
+			Expect(backfill.Status.Created).To(Equal(int32(3)))
+			Expect(backfill.Status.LastSchedule).To(Equal("minutely"))
+		})
+
+		It("should not take the names of the runs of the Cron", func() {
+			r := NewCronBackfillReconciler(scheme, k8sClient, k8sClient, record.NewFakeRecorder(10), nil, nil)
+			cron := &v1alpha1.Cron{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: cronName}, cron)).To(Succeed())
+			backfill := &v1alpha1.CronBackfill{}
+			Expect(k8sClient.Get(ctx, key, backfill)).To(Succeed())
+
+			// The Cron has already run at the first scheduled time.
+			run := &unstructured.Unstructured{}
+			run.SetGroupVersionKind(kubeflowv1.SchemeGroupVersion.WithKind("PyTorchJob"))
+			run.SetNamespace(namespace)
+			run.SetName(getDefaultJobName(cron, from))
+			run.SetLabels(map[string]string{common.LabelCronName: cronName})
+			Expect(controllerutil.SetControllerReference(cron, run, scheme)).To(Succeed())
+			Expect(k8sClient.Create(ctx, run)).To(Succeed())
+
+			// The run of the Cron is kept, and the scheduled time is backfilled by another workload.
+			_, err := r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(run), run)).To(Succeed())
+			Expect(metav1.IsControlledBy(run, cron)).To(BeTrue())
+			workload := &unstructured.Unstructured{}
+			workload.SetGroupVersionKind(kubeflowv1.SchemeGroupVersion.WithKind("PyTorchJob"))
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: getBackfillJobName(cron, backfill, "", from)}, workload)).To(Succeed())
+			Expect(metav1.IsControlledBy(workload, backfill)).To(BeTrue())
+			Expect(k8sClient.Get(ctx, key, backfill)).To(Succeed())
+			Expect(backfill.Status.Created).To(Equal(int32(2)))
+		})
+
+		It("should fail while a workload not controlled by the backfill has the name of a backfilled workload", func() {
+			r := NewCronBackfillReconciler(scheme, k8sClient, k8sClient, record.NewFakeRecorder(10), nil, nil)
+			cron := &v1alpha1.Cron{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: namespace, Name: cronName}, cron)).To(Succeed())
+			backfill := &v1alpha1.CronBackfill{}
+			Expect(k8sClient.Get(ctx, key, backfill)).To(Succeed())
+
+			conflict := &unstructured.Unstructured{}
+			conflict.SetGroupVersionKind(kubeflowv1.SchemeGroupVersion.WithKind("PyTorchJob"))
+			conflict.SetNamespace(namespace)
+			conflict.SetName(getBackfillJobName(cron, backfill, "", from))
+			Expect(k8sClient.Create(ctx, conflict)).To(Succeed())
+
+			result, err := r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(backfillConflictRetryInterval))
+
+			Expect(k8sClient.Get(ctx, key, backfill)).To(Succeed())
+			Expect(backfill.Status.Created).To(BeZero())
+			condition := meta.FindStatusCondition(backfill.Status.Conditions, v1alpha1.CronBackfillConditionFailed)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal(v1alpha1.CronBackfillReasonNameConflict))
+			Expect(condition.Message).To(ContainSubstring(conflict.GetName()))
+
+			// The backfill proceeds once the workload has been deleted.
+			Expect(k8sClient.Delete(ctx, conflict)).To(Succeed())
+			_, err = r.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8sClient.Get(ctx, key, backfill)).To(Succeed())
+			Expect(backfill.Status.Created).To(Equal(int32(2)))
+			Expect(meta.FindStatusCondition(backfill.Status.Conditions, v1alpha1.CronBackfillConditionFailed)).To(BeNil())
+		})
+	})
+
+	Context("getBackfillRuns", func() {
+		It("should return the runs within the time range", func() {
+			cron := &v1alpha1.Cron{Spec: v1alpha1.CronSpec{Schedule: "*/10 * * * *"}}
+			runs, err := getBackfillRuns(cron, from, from.Add(30*time.Minute))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(runs).To(Equal([]backfillRun{
+				{scheduleTime: from},
+				{scheduleTime: from.Add(10 * time.Minute)},
+				{scheduleTime: from.Add(20 * time.Minute)},
+			}))
+		})
+
+		It("should return the runs of each schedule", func() {
+			cron := &v1alpha1.Cron{Spec: v1alpha1.CronSpec{Schedules: []v1alpha1.CronSchedule{
+				{Name: "even", Schedule: "*/2 * * * *"},
+				{Name: "triple", Schedule: "*/3 * * * *"},
+			}}}
+			runs, err := getBackfillRuns(cron, from, from.Add(7*time.Minute))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(runs).To(Equal([]backfillRun{
+				{schedule: "even", index: 0, scheduleTime: from},
+				{schedule: "triple", index: 1, scheduleTime: from},
+				{schedule: "even", index: 0, scheduleTime: from.Add(2 * time.Minute)},
+				{schedule: "triple", index: 1, scheduleTime: from.Add(3 * time.Minute)},
+				{schedule: "even", index: 0, scheduleTime: from.Add(4 * time.Minute)},
+				{schedule: "even", index: 0, scheduleTime: from.Add(6 * time.Minute)},
+				{schedule: "triple", index: 1, scheduleTime: from.Add(6 * time.Minute)},
+			}))
+		})
+
+		It("should return error for too many scheduled times", func() {
+			cron := &v1alpha1.Cron{Spec: v1alpha1.CronSpec{Schedule: "*/1 * * * *"}}
+			_, err := getBackfillRuns(cron, from, from.AddDate(0, 1, 0))
+			Expect(err).To(MatchError(errTooManyScheduleTimes))
+		})
+	})
+})
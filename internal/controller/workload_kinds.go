@@ -0,0 +1,187 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/AliyunContainerService/cron-operator/pkg/common"
+)
+
+// getKubeflowJobKinds returns the kinds of the Kubeflow jobs registered in the given scheme, sorted by kind.
+func getKubeflowJobKinds(scheme *runtime.Scheme) []schema.GroupVersionKind {
+	knownTypes := scheme.KnownTypes(kubeflowv1.SchemeGroupVersion)
+	var kinds []schema.GroupVersionKind
+	for kind := range knownTypes {
+		if _, ok := knownTypes[kind+"List"]; ok && !strings.HasSuffix(kind, "List") {
+			kinds = append(kinds, kubeflowv1.SchemeGroupVersion.WithKind(kind))
+		}
+	}
+	slices.SortFunc(kinds, func(a, b schema.GroupVersionKind) int {
+		return strings.Compare(a.Kind, b.Kind)
+	})
+	return kinds
+}
+
+// getOwnedWorkloadTypes returns the workload types to watch for the runs of Crons, which are batch/v1 Jobs,
+// Pods, every kind of Kubeflow job registered in the scheme and the kinds with a status adapter, like TrainJobs.
+// Kinds whose CRD is not installed are returned separately as they cannot be watched.
+func getOwnedWorkloadTypes(scheme *runtime.Scheme, mapper meta.RESTMapper) ([]client.Object, []schema.GroupVersionKind, error) {
+	owned := []client.Object{&batchv1.Job{}, &corev1.Pod{}}
+	var missing []schema.GroupVersionKind
+	for _, gvk := range append(getKubeflowJobKinds(scheme), getAdaptedWorkloadKinds()...) {
+		if _, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version); err != nil {
+			if meta.IsNoMatchError(err) {
+				missing = append(missing, gvk)
+				continue
+			}
+			return nil, nil, fmt.Errorf("failed to get REST mapping of %s: %w", gvk.Kind, err)
+		}
+
+		// Kinds whose types are not registered in the scheme are watched as unstructured objects.
+		if !scheme.Recognizes(gvk) {
+			workload := &unstructured.Unstructured{}
+			workload.SetGroupVersionKind(gvk)
+			owned = append(owned, workload)
+			continue
+		}
+		obj, err := scheme.New(gvk)
+		if err != nil {
+			return nil, nil, err
+		}
+		workload, ok := obj.(client.Object)
+		if !ok {
+			return nil, nil, fmt.Errorf("%s is not a client.Object", gvk.Kind)
+		}
+		owned = append(owned, workload)
+	}
+	return owned, missing, nil
+}
+
+// WorkloadCacheByObject returns the cache options of batch/v1 Jobs and Pods, which are only cached when they
+// have the Cron name label, as the cluster may have many more of them than the workloads created by Crons.
+func WorkloadCacheByObject() map[client.Object]cache.ByObject {
+	selector := labels.NewSelector()
+	requirement, _ := labels.NewRequirement(common.LabelCronName, selection.Exists, nil)
+	selector = selector.Add(*requirement)
+	return map[client.Object]cache.ByObject{
+		&batchv1.Job{}: {Label: selector},
+		&corev1.Pod{}:  {Label: selector},
+	}
+}
+
+// ownWorkloads sets up the given controller to watch the workloads it owns, and returns the kinds it watches.
+// Kinds whose CRD is not installed are skipped, so that the operator still starts without them.
+func ownWorkloads(mgr ctrl.Manager, b *builder.Builder) (*builder.Builder, sets.Set[schema.GroupKind], error) {
+	owned, missing, err := getOwnedWorkloadTypes(mgr.GetScheme(), mgr.GetRESTMapper())
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, gvk := range missing {
+		mgr.GetLogger().Info("Skip watching workloads as their CRD is not installed", "kind", gvk.Kind, "apiVersion", gvk.GroupVersion().String())
+	}
+	watched := sets.New[schema.GroupKind]()
+	for _, workload := range owned {
+		gvk, err := apiutil.GVKForObject(workload, mgr.GetScheme())
+		if err != nil {
+			return nil, nil, err
+		}
+		watched.Insert(gvk.GroupKind())
+		b = b.Owns(workload)
+	}
+	return b, watched, nil
+}
+
+// workloadWatcher starts watches on the workloads of kinds that are not watched at startup, e.g. in-house CRDs,
+// the first time they are seen in a template. Like the watches set up at startup, their events are enqueued for
+// the controller owner of the workloads. A nil workloadWatcher does not watch any workloads.
+type workloadWatcher struct {
+	controller controller.Controller
+	cache      cache.Cache
+	scheme     *runtime.Scheme
+	mapper     meta.RESTMapper
+	owner      client.Object
+
+	mu sync.Mutex
+	// watched are the kinds whose workloads are watched. Watching a single version of a kind is enough,
+	// as every version serves all of its objects.
+	watched sets.Set[schema.GroupKind]
+}
+
+// newWorkloadWatcher returns a workloadWatcher of the given controller, which owns workloads with owners of the
+// given type and already watches the workloads of the given kinds.
+func newWorkloadWatcher(mgr ctrl.Manager, c controller.Controller, owner client.Object, watched sets.Set[schema.GroupKind]) *workloadWatcher {
+	return &workloadWatcher{
+		controller: c,
+		cache:      mgr.GetCache(),
+		scheme:     mgr.GetScheme(),
+		mapper:     mgr.GetRESTMapper(),
+		owner:      owner,
+		watched:    watched,
+	}
+}
+
+// watch starts watching the workloads of the given kind unless they are already watched. It returns a no-match
+// error if the CRD of the kind is not installed.
+func (w *workloadWatcher) watch(ctx context.Context, gvk schema.GroupVersionKind) error {
+	if w == nil {
+		return nil
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.watched.Has(gvk.GroupKind()) {
+		return nil
+	}
+	if _, err := w.mapper.RESTMapping(gvk.GroupKind(), gvk.Version); err != nil {
+		return err
+	}
+
+	workload := &unstructured.Unstructured{}
+	workload.SetGroupVersionKind(gvk)
+	eventHandler := handler.EnqueueRequestForOwner(w.scheme, w.mapper, w.owner, handler.OnlyControllerOwner())
+	if err := w.controller.Watch(source.Kind[client.Object](w.cache, workload, eventHandler)); err != nil {
+		return fmt.Errorf("failed to watch %s: %w", gvk.Kind, err)
+	}
+	w.watched.Insert(gvk.GroupKind())
+	logf.FromContext(ctx).Info("Started watching workloads", "kind", gvk.Kind, "apiVersion", gvk.GroupVersion().String())
+	return nil
+}
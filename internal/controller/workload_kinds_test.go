@@ -0,0 +1,308 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/AliyunContainerService/cron-operator/api/v1alpha1"
+	"github.com/AliyunContainerService/cron-operator/pkg/common"
+)
+
+// watchRecorder is a controller recording the sources it is asked to watch.
+type watchRecorder struct {
+	controller.Controller
+	sources []source.Source
+}
+
+func (c *watchRecorder) Watch(src source.Source) error {
+	c.sources = append(c.sources, src)
+	return nil
+}
+
+var _ = Describe("Workload kinds", func() {
+	ctx := context.Background()
+	kubeflowScheme := runtime.NewScheme()
+	Expect(kubeflowv1.AddToScheme(kubeflowScheme)).To(Succeed())
+
+	Context("getKubeflowJobKinds", func() {
+		It("should return every kind of Kubeflow job", func() {
+			kinds := []string{}
+			for _, gvk := range getKubeflowJobKinds(kubeflowScheme) {
+				Expect(gvk.GroupVersion()).To(Equal(kubeflowv1.SchemeGroupVersion))
+				kinds = append(kinds, gvk.Kind)
+			}
+			Expect(kinds).To(Equal([]string{"JAXJob", "MPIJob", "PaddleJob", "PyTorchJob", "TFJob", "XGBoostJob"}))
+		})
+	})
+
+	Context("getOwnedWorkloadTypes", func() {
+		It("should skip the kinds whose CRD is not installed", func() {
+			mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{kubeflowv1.SchemeGroupVersion})
+			mapper.Add(kubeflowv1.SchemeGroupVersion.WithKind(kubeflowv1.PyTorchJobKind), meta.RESTScopeNamespace)
+
+			owned, missing, err := getOwnedWorkloadTypes(kubeflowScheme, mapper)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(owned).To(HaveExactElements(
+				BeAssignableToTypeOf(&batchv1.Job{}),
+				BeAssignableToTypeOf(&corev1.Pod{}),
+				BeAssignableToTypeOf(&kubeflowv1.PyTorchJob{}),
+			))
+			Expect(missing).To(HaveLen(8))
+			Expect(missing).NotTo(ContainElement(HaveField("Kind", kubeflowv1.PyTorchJobKind)))
+		})
+
+		It("should watch the kinds with a status adapter as unstructured objects", func() {
+			mapper := meta.NewDefaultRESTMapper(nil)
+			mapper.Add(trainJobGVK, meta.RESTScopeNamespace)
+
+			owned, missing, err := getOwnedWorkloadTypes(kubeflowScheme, mapper)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(owned).To(HaveLen(3))
+			Expect(owned[2]).To(BeAssignableToTypeOf(&unstructured.Unstructured{}))
+			Expect(owned[2].GetObjectKind().GroupVersionKind()).To(Equal(trainJobGVK))
+			Expect(missing).To(ContainElements(jobSetGVK, rayJobGVK))
+		})
+	})
+
+	Context("WorkloadCacheByObject", func() {
+		It("should only cache the Jobs and Pods with the Cron name label", func() {
+			byObject := WorkloadCacheByObject()
+			Expect(byObject).To(HaveLen(2))
+			for obj, options := range byObject {
+				Expect(obj).To(Or(BeAssignableToTypeOf(&batchv1.Job{}), BeAssignableToTypeOf(&corev1.Pod{})))
+				Expect(options.Label.Matches(labels.Set{common.LabelCronName: "cron"})).To(BeTrue())
+				Expect(options.Label.Matches(labels.Set{"app": "cron"})).To(BeFalse())
+			}
+		})
+	})
+
+	Context("workloadWatcher", func() {
+		training := schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Training"}
+		cronScheme := runtime.NewScheme()
+		Expect(v1alpha1.AddToScheme(cronScheme)).To(Succeed())
+
+		newWatcher := func(c controller.Controller) *workloadWatcher {
+			mapper := meta.NewDefaultRESTMapper(nil)
+			mapper.Add(training, meta.RESTScopeNamespace)
+			return &workloadWatcher{
+				controller: c,
+				scheme:     cronScheme,
+				mapper:     mapper,
+				owner:      &v1alpha1.Cron{},
+				watched:    sets.New(batchv1.SchemeGroupVersion.WithKind("Job").GroupKind()),
+			}
+		}
+
+		It("should watch the workloads of a new kind once", func() {
+			c := &watchRecorder{}
+			w := newWatcher(c)
+			Expect(w.watch(ctx, training)).To(Succeed())
+			Expect(w.watch(ctx, training.GroupKind().WithVersion("v2"))).To(Succeed())
+			Expect(c.sources).To(HaveLen(1))
+			Expect(w.watched.Has(training.GroupKind())).To(BeTrue())
+		})
+
+		It("should not watch the kinds watched at startup again", func() {
+			c := &watchRecorder{}
+			Expect(newWatcher(c).watch(ctx, batchv1.SchemeGroupVersion.WithKind("Job"))).To(Succeed())
+			Expect(c.sources).To(BeEmpty())
+		})
+
+		It("should reject the kinds whose CRD is not installed", func() {
+			c := &watchRecorder{}
+			w := newWatcher(c)
+			err := w.watch(ctx, schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Evaluation"})
+			Expect(meta.IsNoMatchError(err)).To(BeTrue())
+			Expect(c.sources).To(BeEmpty())
+			Expect(w.watched.Len()).To(Equal(1))
+		})
+
+		It("should not watch anything without a controller", func() {
+			var w *workloadWatcher
+			Expect(w.watch(ctx, training)).To(Succeed())
+		})
+	})
+
+	Context("When reconciling a resource of each workload kind", func() {
+		const (
+			name      = "cron-kind-test"
+			namespace = "default"
+		)
+
+		key := client.ObjectKey{Namespace: namespace, Name: name}
+
+		It("should watch every kind of Kubeflow job and adapted workload with its CRD installed", func() {
+			owned, missing, err := getOwnedWorkloadTypes(scheme, k8sClient.RESTMapper())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(missing).To(BeEmpty())
+			Expect(owned).To(HaveLen(2 + len(getKubeflowJobKinds(scheme)) + len(getAdaptedWorkloadKinds())))
+		})
+
+		DescribeTable("should record a finished run",
+			func(kind string) {
+				gvk := kubeflowv1.SchemeGroupVersion.WithKind(kind)
+				cron := &v1alpha1.Cron{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+					Spec: v1alpha1.CronSpec{
+						Schedule:          "*/1 * * * *",
+						ConcurrencyPolicy: v1alpha1.ConcurrentPolicyForbid,
+						Template: v1alpha1.CronTemplateSpec{
+							Workload: &runtime.RawExtension{
+								Raw: []byte(fmt.Sprintf(`{"apiVersion":%q,"kind":%q}`, gvk.GroupVersion().String(), kind)),
+							},
+						},
+					},
+				}
+				Expect(k8sClient.Create(ctx, cron)).To(Succeed())
+				defer func() {
+					Expect(k8sClient.Delete(ctx, cron)).To(Succeed())
+					workload := &unstructured.Unstructured{}
+					workload.SetGroupVersionKind(gvk)
+					Expect(k8sClient.DeleteAllOf(ctx, workload, client.InNamespace(namespace))).To(Succeed())
+				}()
+				cron.Status.LastScheduleTime = &metav1.Time{Time: time.Now().Add(-90 * time.Second)}
+				Expect(k8sClient.Status().Update(ctx, cron)).To(Succeed())
+
+				r := NewCronReconciler(scheme, k8sClient, k8sClient, record.NewFakeRecorder(10), nil, nil)
+				_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+				Expect(err).NotTo(HaveOccurred())
+
+				uList := &unstructured.UnstructuredList{}
+				uList.SetGroupVersionKind(gvk)
+				Expect(k8sClient.List(ctx, uList, client.InNamespace(namespace), client.MatchingLabels{common.LabelCronName: name})).To(Succeed())
+				Expect(uList.Items).To(HaveLen(1))
+				workload := &uList.Items[0]
+
+				workload.Object["status"] = map[string]interface{}{
+					"conditions":      []interface{}{map[string]interface{}{"type": string(kubeflowv1.JobSucceeded), "status": string(corev1.ConditionTrue)}},
+					"replicaStatuses": map[string]interface{}{},
+				}
+				Expect(k8sClient.Status().Update(ctx, workload)).To(Succeed())
+				_, err = r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+				Expect(cron.Status.Active).To(BeEmpty())
+				Expect(cron.Status.History).To(ContainElement(And(
+					HaveField("Object.Kind", kind),
+					HaveField("Object.Name", workload.GetName()),
+					HaveField("Status", kubeflowv1.JobSucceeded),
+					HaveField("StatusInterpreter", v1alpha1.StatusInterpreterKubeflow),
+				)))
+			},
+			Entry("JAXJob", "JAXJob"),
+			Entry("MPIJob", "MPIJob"),
+			Entry("PaddleJob", "PaddleJob"),
+			Entry("PyTorchJob", "PyTorchJob"),
+			Entry("TFJob", "TFJob"),
+			Entry("XGBoostJob", "XGBoostJob"),
+		)
+
+		DescribeTable("should record a finished run of a kind whose status is not a Kubeflow job status",
+			func(gvk schema.GroupVersionKind, status map[string]interface{}, expected kubeflowv1.JobConditionType, interpreter v1alpha1.StatusInterpreter) {
+				cron := &v1alpha1.Cron{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+					Spec: v1alpha1.CronSpec{
+						Schedule:          "*/1 * * * *",
+						ConcurrencyPolicy: v1alpha1.ConcurrentPolicyForbid,
+						Template: v1alpha1.CronTemplateSpec{
+							Workload: &runtime.RawExtension{
+								Raw: []byte(fmt.Sprintf(`{"apiVersion":%q,"kind":%q}`, gvk.GroupVersion().String(), gvk.Kind)),
+							},
+						},
+					},
+				}
+				Expect(k8sClient.Create(ctx, cron)).To(Succeed())
+				defer func() {
+					Expect(k8sClient.Delete(ctx, cron)).To(Succeed())
+					workload := &unstructured.Unstructured{}
+					workload.SetGroupVersionKind(gvk)
+					Expect(k8sClient.DeleteAllOf(ctx, workload, client.InNamespace(namespace))).To(Succeed())
+				}()
+				cron.Status.LastScheduleTime = &metav1.Time{Time: time.Now().Add(-90 * time.Second)}
+				Expect(k8sClient.Status().Update(ctx, cron)).To(Succeed())
+
+				r := NewCronReconciler(scheme, k8sClient, k8sClient, record.NewFakeRecorder(10), nil, nil)
+				_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+				Expect(err).NotTo(HaveOccurred())
+
+				uList := &unstructured.UnstructuredList{}
+				uList.SetGroupVersionKind(gvk)
+				Expect(k8sClient.List(ctx, uList, client.InNamespace(namespace), client.MatchingLabels{common.LabelCronName: name})).To(Succeed())
+				Expect(uList.Items).To(HaveLen(1))
+				workload := &uList.Items[0]
+
+				workload.Object["status"] = status
+				Expect(k8sClient.Status().Update(ctx, workload)).To(Succeed())
+				_, err = r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(k8sClient.Get(ctx, key, cron)).To(Succeed())
+				Expect(cron.Status.Active).To(BeEmpty())
+				Expect(cron.Status.History).To(ContainElement(And(
+					HaveField("Object.Kind", gvk.Kind),
+					HaveField("Object.Name", workload.GetName()),
+					HaveField("Status", expected),
+					HaveField("StatusInterpreter", interpreter),
+				)))
+			},
+			Entry("TrainJob", trainJobGVK, map[string]interface{}{
+				"conditions": []interface{}{map[string]interface{}{
+					"type": "Complete", "status": "True", "reason": "JobsCompleted", "message": "", "lastTransitionTime": "2026-01-01T00:00:00Z",
+				}},
+			}, kubeflowv1.JobSucceeded, v1alpha1.StatusInterpreterBuiltIn),
+			Entry("JobSet", jobSetGVK, map[string]interface{}{
+				"terminalState": "Failed",
+				"conditions": []interface{}{map[string]interface{}{
+					"type": "Failed", "status": "True", "reason": "FailedJobs", "message": "jobset failed", "lastTransitionTime": "2026-01-01T00:00:00Z",
+				}},
+			}, kubeflowv1.JobFailed, v1alpha1.StatusInterpreterBuiltIn),
+			Entry("RayJob", rayJobGVK, map[string]interface{}{
+				"jobDeploymentStatus": "Complete",
+				"jobStatus":           "SUCCEEDED",
+			}, kubeflowv1.JobSucceeded, v1alpha1.StatusInterpreterBuiltIn),
+			Entry("kind following the kstatus conventions", schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "BatchRun"}, map[string]interface{}{
+				"observedGeneration": int64(1),
+				"conditions": []interface{}{map[string]interface{}{
+					"type": "Stalled", "status": "True", "reason": "OutOfMemory", "message": "", "lastTransitionTime": "2026-01-01T00:00:00Z",
+				}},
+			}, kubeflowv1.JobFailed, v1alpha1.StatusInterpreterKStatus),
+		)
+	})
+})
@@ -0,0 +1,477 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"slices"
+
+	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/AliyunContainerService/cron-operator/api/v1alpha1"
+	"github.com/AliyunContainerService/cron-operator/internal/statusrule"
+)
+
+// statusAdapter converts the status of a workload whose kind is not a Kubeflow job into a Kubeflow job status,
+// so that it is classified as active, succeeded or failed like Kubeflow jobs. The condition of the final
+// status of a finished workload comes last.
+type statusAdapter func(status map[string]interface{}) (kubeflowv1.JobStatus, error)
+
+var (
+	// trainJobGVK is the kind of the Kubeflow Trainer v2 TrainJob.
+	trainJobGVK = schema.GroupVersionKind{Group: "trainer.kubeflow.org", Version: "v1alpha1", Kind: "TrainJob"}
+	// jobSetGVK is the kind of the JobSet of the Kubernetes batch working group.
+	jobSetGVK = schema.GroupVersionKind{Group: "jobset.x-k8s.io", Version: "v1alpha2", Kind: "JobSet"}
+	// rayJobGVK is the kind of the RayJob of KubeRay.
+	rayJobGVK = schema.GroupVersionKind{Group: "ray.io", Version: "v1", Kind: "RayJob"}
+)
+
+// workloadAdapter adapts a workload kind that is not a Kubeflow job to the handling of Kubeflow jobs.
+type workloadAdapter struct {
+	// status converts the status of the workloads of the kind.
+	status statusAdapter
+	// suspendField is the path of the boolean field suspending the workloads of the kind,
+	// or nil if they cannot be suspended.
+	suspendField []string
+}
+
+// kubeflowSuspendField is the path of the field suspending a Kubeflow job.
+var kubeflowSuspendField = []string{"spec", "runPolicy", "suspend"}
+
+// workloadAdapters are the adapters of the workload kinds whose status is not a Kubeflow job status.
+var workloadAdapters = map[schema.GroupKind]workloadAdapter{
+	batchv1.SchemeGroupVersion.WithKind("Job").GroupKind(): {status: getBatchJobStatus, suspendField: []string{"spec", "suspend"}},
+	corev1.SchemeGroupVersion.WithKind("Pod").GroupKind():  {status: getPodStatus},
+	trainJobGVK.GroupKind():                                {status: getTrainJobStatus, suspendField: []string{"spec", "suspend"}},
+	jobSetGVK.GroupKind():                                  {status: getJobSetStatus, suspendField: []string{"spec", "suspend"}},
+	rayJobGVK.GroupKind():                                  {status: getRayJobStatus, suspendField: []string{"spec", "suspend"}},
+}
+
+// getAdaptedWorkloadKinds returns the kinds of the workloads with a status adapter whose types are not
+// registered in the scheme of the operator, which are watched as unstructured objects if their CRD is installed.
+func getAdaptedWorkloadKinds() []schema.GroupVersionKind {
+	return []schema.GroupVersionKind{trainJobGVK, jobSetGVK, rayJobGVK}
+}
+
+// batchJobConditionTypes maps the condition types of a batch/v1 Job to the ones of a Kubeflow job.
+var batchJobConditionTypes = map[batchv1.JobConditionType]kubeflowv1.JobConditionType{
+	batchv1.JobSuspended: kubeflowv1.JobSuspended,
+	batchv1.JobComplete:  kubeflowv1.JobSucceeded,
+	batchv1.JobFailed:    kubeflowv1.JobFailed,
+}
+
+// getBatchJobStatus converts the status of a batch/v1 Job, which has finished once its Complete or Failed
+// condition is true.
+func getBatchJobStatus(status map[string]interface{}) (kubeflowv1.JobStatus, error) {
+	jobStatus := batchv1.JobStatus{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(status, &jobStatus); err != nil {
+		return kubeflowv1.JobStatus{}, fmt.Errorf("failed to convert Job status from unstructured: %v", err)
+	}
+
+	result := kubeflowv1.JobStatus{
+		StartTime:      jobStatus.StartTime,
+		CompletionTime: jobStatus.CompletionTime,
+	}
+	if jobStatus.Active > 0 {
+		result.Conditions = append(result.Conditions, kubeflowv1.JobCondition{
+			Type:   kubeflowv1.JobRunning,
+			Status: corev1.ConditionTrue,
+		})
+	}
+
+	var finished []kubeflowv1.JobCondition
+	for _, condition := range jobStatus.Conditions {
+		conditionType, ok := batchJobConditionTypes[condition.Type]
+		if !ok {
+			continue
+		}
+		converted := kubeflowv1.JobCondition{
+			Type:               conditionType,
+			Status:             condition.Status,
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+			LastUpdateTime:     condition.LastProbeTime,
+			LastTransitionTime: condition.LastTransitionTime,
+		}
+		if conditionType != kubeflowv1.JobSuspended && condition.Status == corev1.ConditionTrue {
+			finished = append(finished, converted)
+			continue
+		}
+		result.Conditions = append(result.Conditions, converted)
+	}
+	result.Conditions = append(result.Conditions, finished...)
+	return result, nil
+}
+
+// getPodStatus converts the status of a bare Pod, which has finished once it is in the Succeeded or Failed phase.
+func getPodStatus(status map[string]interface{}) (kubeflowv1.JobStatus, error) {
+	podStatus := corev1.PodStatus{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(status, &podStatus); err != nil {
+		return kubeflowv1.JobStatus{}, fmt.Errorf("failed to convert Pod status from unstructured: %v", err)
+	}
+
+	result := kubeflowv1.JobStatus{StartTime: podStatus.StartTime}
+	var conditionType kubeflowv1.JobConditionType
+	switch podStatus.Phase {
+	case corev1.PodPending:
+		conditionType = kubeflowv1.JobCreated
+	case corev1.PodRunning:
+		conditionType = kubeflowv1.JobRunning
+	case corev1.PodSucceeded:
+		conditionType = kubeflowv1.JobSucceeded
+	case corev1.PodFailed:
+		conditionType = kubeflowv1.JobFailed
+	default:
+		return result, nil
+	}
+
+	condition := kubeflowv1.JobCondition{
+		Type:    conditionType,
+		Status:  corev1.ConditionTrue,
+		Reason:  podStatus.Reason,
+		Message: podStatus.Message,
+	}
+	if conditionType == kubeflowv1.JobSucceeded || conditionType == kubeflowv1.JobFailed {
+		// A Pod finishes when its last container terminates.
+		for _, containerStatus := range podStatus.ContainerStatuses {
+			terminated := containerStatus.State.Terminated
+			if terminated != nil && terminated.FinishedAt.After(condition.LastTransitionTime.Time) {
+				condition.LastTransitionTime = terminated.FinishedAt
+			}
+		}
+		if !condition.LastTransitionTime.IsZero() {
+			result.CompletionTime = &metav1.Time{Time: condition.LastTransitionTime.Time}
+		}
+	}
+	result.Conditions = []kubeflowv1.JobCondition{condition}
+	return result, nil
+}
+
+// replicatedJobStatus is the status of the Jobs of a replicated job of a TrainJob or a JobSet.
+type replicatedJobStatus struct {
+	Name   string `json:"name"`
+	Active int32  `json:"active"`
+}
+
+// trainJobStatus is the status of a Kubeflow Trainer v2 TrainJob.
+type trainJobStatus struct {
+	Conditions []metav1.Condition    `json:"conditions,omitempty"`
+	JobsStatus []replicatedJobStatus `json:"jobsStatus,omitempty"`
+}
+
+// jobSetStatus is the status of a JobSet.
+type jobSetStatus struct {
+	Conditions           []metav1.Condition    `json:"conditions,omitempty"`
+	ReplicatedJobsStatus []replicatedJobStatus `json:"replicatedJobsStatus,omitempty"`
+}
+
+// trainJobConditionTypes maps the condition types of a TrainJob to the ones of a Kubeflow job.
+var trainJobConditionTypes = map[string]kubeflowv1.JobConditionType{
+	"Suspended": kubeflowv1.JobSuspended,
+	"Complete":  kubeflowv1.JobSucceeded,
+	"Failed":    kubeflowv1.JobFailed,
+}
+
+// jobSetConditionTypes maps the condition types of a JobSet to the ones of a Kubeflow job.
+var jobSetConditionTypes = map[string]kubeflowv1.JobConditionType{
+	"Suspended": kubeflowv1.JobSuspended,
+	"Completed": kubeflowv1.JobSucceeded,
+	"Failed":    kubeflowv1.JobFailed,
+}
+
+// getTrainJobStatus converts the status of a TrainJob, which has finished once its Complete or Failed
+// condition is true, and is running while any of its Jobs is active.
+func getTrainJobStatus(status map[string]interface{}) (kubeflowv1.JobStatus, error) {
+	trainJobStatus := trainJobStatus{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(status, &trainJobStatus); err != nil {
+		return kubeflowv1.JobStatus{}, fmt.Errorf("failed to convert TrainJob status from unstructured: %v", err)
+	}
+	return convertConditions(trainJobStatus.Conditions, trainJobConditionTypes, trainJobStatus.JobsStatus), nil
+}
+
+// getJobSetStatus converts the status of a JobSet, which has finished once its Completed or Failed
+// condition is true, and is running while any of its Jobs is active.
+func getJobSetStatus(status map[string]interface{}) (kubeflowv1.JobStatus, error) {
+	jobSetStatus := jobSetStatus{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(status, &jobSetStatus); err != nil {
+		return kubeflowv1.JobStatus{}, fmt.Errorf("failed to convert JobSet status from unstructured: %v", err)
+	}
+	return convertConditions(jobSetStatus.Conditions, jobSetConditionTypes, jobSetStatus.ReplicatedJobsStatus), nil
+}
+
+// convertConditions converts the conditions of a workload made of replicated jobs, like a TrainJob or a JobSet,
+// with the given mapping of their types. Conditions of other types are dropped, and the workload is running while
+// any of the given replicated jobs has active Jobs.
+func convertConditions(conditions []metav1.Condition, conditionTypes map[string]kubeflowv1.JobConditionType, replicatedJobs []replicatedJobStatus) kubeflowv1.JobStatus {
+	result := kubeflowv1.JobStatus{}
+	if slices.ContainsFunc(replicatedJobs, func(s replicatedJobStatus) bool { return s.Active > 0 }) {
+		result.Conditions = append(result.Conditions, kubeflowv1.JobCondition{
+			Type:   kubeflowv1.JobRunning,
+			Status: corev1.ConditionTrue,
+		})
+	}
+
+	var finished []kubeflowv1.JobCondition
+	for _, condition := range conditions {
+		conditionType, ok := conditionTypes[condition.Type]
+		if !ok {
+			continue
+		}
+		converted := kubeflowv1.JobCondition{
+			Type:               conditionType,
+			Status:             corev1.ConditionStatus(condition.Status),
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+			LastUpdateTime:     condition.LastTransitionTime,
+			LastTransitionTime: condition.LastTransitionTime,
+		}
+		if conditionType != kubeflowv1.JobSuspended && condition.Status == metav1.ConditionTrue {
+			finished = append(finished, converted)
+			if result.CompletionTime == nil && !condition.LastTransitionTime.IsZero() {
+				result.CompletionTime = &metav1.Time{Time: condition.LastTransitionTime.Time}
+			}
+			continue
+		}
+		result.Conditions = append(result.Conditions, converted)
+	}
+	result.Conditions = append(result.Conditions, finished...)
+	return result
+}
+
+// rayJobStatus is the status of a KubeRay RayJob.
+type rayJobStatus struct {
+	JobStatus           string       `json:"jobStatus,omitempty"`
+	JobDeploymentStatus string       `json:"jobDeploymentStatus,omitempty"`
+	Reason              string       `json:"reason,omitempty"`
+	Message             string       `json:"message,omitempty"`
+	StartTime           *metav1.Time `json:"startTime,omitempty"`
+	EndTime             *metav1.Time `json:"endTime,omitempty"`
+}
+
+// getRayJobStatus converts the status of a RayJob, which has finished once its deployment is Complete or Failed.
+// A complete RayJob has succeeded only if its Ray job has, as a Ray job that failed or was stopped also
+// completes the deployment.
+func getRayJobStatus(status map[string]interface{}) (kubeflowv1.JobStatus, error) {
+	rayJobStatus := rayJobStatus{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(status, &rayJobStatus); err != nil {
+		return kubeflowv1.JobStatus{}, fmt.Errorf("failed to convert RayJob status from unstructured: %v", err)
+	}
+
+	result := kubeflowv1.JobStatus{StartTime: rayJobStatus.StartTime}
+	var conditionType kubeflowv1.JobConditionType
+	switch rayJobStatus.JobDeploymentStatus {
+	case "Initializing", "Waiting", "Retrying":
+		conditionType = kubeflowv1.JobCreated
+	case "Running":
+		conditionType = kubeflowv1.JobRunning
+	case "Suspending", "Suspended":
+		conditionType = kubeflowv1.JobSuspended
+	case "Complete":
+		conditionType = kubeflowv1.JobFailed
+		if rayJobStatus.JobStatus == "SUCCEEDED" {
+			conditionType = kubeflowv1.JobSucceeded
+		}
+	case "Failed":
+		conditionType = kubeflowv1.JobFailed
+	default:
+		return result, nil
+	}
+
+	condition := kubeflowv1.JobCondition{
+		Type:    conditionType,
+		Status:  corev1.ConditionTrue,
+		Reason:  rayJobStatus.Reason,
+		Message: rayJobStatus.Message,
+	}
+	if (conditionType == kubeflowv1.JobSucceeded || conditionType == kubeflowv1.JobFailed) && rayJobStatus.EndTime != nil {
+		condition.LastTransitionTime = *rayJobStatus.EndTime
+		result.CompletionTime = rayJobStatus.EndTime
+	}
+	result.Conditions = []kubeflowv1.JobCondition{condition}
+	return result, nil
+}
+
+// getRuleStatus converts the status of a workload interpreted by status rules, which is failed, succeeded,
+// running or created. The message of the status rules is the message of the condition.
+func getRuleStatus(rules *statusrule.Program, u *unstructured.Unstructured) (kubeflowv1.JobStatus, error) {
+	status, err := rules.Evaluate(u.Object)
+	if err != nil {
+		return kubeflowv1.JobStatus{}, fmt.Errorf("failed to interpret %s status with status rules: %v", u.GetKind(), err)
+	}
+
+	conditionType := kubeflowv1.JobCreated
+	switch {
+	case status.Failed:
+		conditionType = kubeflowv1.JobFailed
+	case status.Succeeded:
+		conditionType = kubeflowv1.JobSucceeded
+	case status.Running:
+		conditionType = kubeflowv1.JobRunning
+	}
+	return kubeflowv1.JobStatus{
+		Conditions: []kubeflowv1.JobCondition{{
+			Type:    conditionType,
+			Status:  corev1.ConditionTrue,
+			Message: status.Message,
+		}},
+	}, nil
+}
+
+// The statuses of a workload computed following the kstatus conventions of sigs.k8s.io/cli-utils.
+const (
+	kstatusCurrent    = "Current"
+	kstatusInProgress = "InProgress"
+	kstatusFailed     = "Failed"
+)
+
+// kstatusConditions are the fields of the status of a workload following the kstatus conventions.
+type kstatusConditions struct {
+	ObservedGeneration *int64             `json:"observedGeneration,omitempty"`
+	Conditions         []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// computeKStatus computes the kstatus status of a workload of the given generation with the given status, and
+// returns the condition it was computed from if any. A workload has failed once it is stalled, and is current only
+// once its Ready condition is true with its latest generation observed, either in its status or by the condition.
+// Unlike kstatus, a workload without a Ready condition is in progress rather than current, as a run must not be
+// considered finished while it may still be running.
+func computeKStatus(generation int64, deleting bool, status kstatusConditions) (string, *metav1.Condition) {
+	if deleting || (status.ObservedGeneration != nil && *status.ObservedGeneration < generation) {
+		return kstatusInProgress, nil
+	}
+	if condition := meta.FindStatusCondition(status.Conditions, "Stalled"); condition != nil && condition.Status == metav1.ConditionTrue {
+		return kstatusFailed, condition
+	}
+	if condition := meta.FindStatusCondition(status.Conditions, "Reconciling"); condition != nil && condition.Status == metav1.ConditionTrue {
+		return kstatusInProgress, condition
+	}
+	condition := meta.FindStatusCondition(status.Conditions, "Ready")
+	if condition == nil || condition.Status != metav1.ConditionTrue {
+		return kstatusInProgress, condition
+	}
+	observedGeneration := condition.ObservedGeneration
+	if status.ObservedGeneration != nil {
+		observedGeneration = *status.ObservedGeneration
+	}
+	if observedGeneration < generation {
+		return kstatusInProgress, condition
+	}
+	return kstatusCurrent, condition
+}
+
+// kstatusConditionTypes maps the kstatus statuses to the condition types of a Kubeflow job.
+var kstatusConditionTypes = map[string]kubeflowv1.JobConditionType{
+	kstatusInProgress: kubeflowv1.JobRunning,
+	kstatusCurrent:    kubeflowv1.JobSucceeded,
+	kstatusFailed:     kubeflowv1.JobFailed,
+}
+
+// getKStatus converts the status of a workload of a kind without a specific interpretation following the kstatus
+// conventions, so that it has succeeded once Current and failed once Failed. A workload whose status reports
+// neither conditions nor an observed generation has not been picked up by its controller yet, and is only created.
+func getKStatus(u *unstructured.Unstructured, status map[string]interface{}) (kubeflowv1.JobStatus, error) {
+	conditions := kstatusConditions{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(status, &conditions); err != nil {
+		return kubeflowv1.JobStatus{}, fmt.Errorf("failed to convert %s status from unstructured: %v", u.GetKind(), err)
+	}
+	if conditions.ObservedGeneration == nil && len(conditions.Conditions) == 0 {
+		return kubeflowv1.JobStatus{
+			Conditions: []kubeflowv1.JobCondition{{Type: kubeflowv1.JobCreated, Status: corev1.ConditionTrue}},
+		}, nil
+	}
+
+	kstatus, condition := computeKStatus(u.GetGeneration(), u.GetDeletionTimestamp() != nil, conditions)
+	converted := kubeflowv1.JobCondition{
+		Type:   kstatusConditionTypes[kstatus],
+		Status: corev1.ConditionTrue,
+	}
+	result := kubeflowv1.JobStatus{}
+	if condition != nil {
+		converted.Reason = condition.Reason
+		converted.Message = condition.Message
+		converted.LastUpdateTime = condition.LastTransitionTime
+		converted.LastTransitionTime = condition.LastTransitionTime
+		if kstatus != kstatusInProgress && !condition.LastTransitionTime.IsZero() {
+			result.CompletionTime = &metav1.Time{Time: condition.LastTransitionTime.Time}
+		}
+	}
+	result.Conditions = []kubeflowv1.JobCondition{converted}
+	return result, nil
+}
+
+// getStatusAdapter returns the status adapter of the kind of the given workload, or nil if its kind
+// has no built-in interpretation.
+func getStatusAdapter(u *unstructured.Unstructured) statusAdapter {
+	return workloadAdapters[u.GroupVersionKind().GroupKind()].status
+}
+
+// getSuspendField returns the path of the field suspending the given workload, or nil if its kind
+// cannot be suspended.
+func getSuspendField(u *unstructured.Unstructured) []string {
+	if u.GroupVersionKind().Group == kubeflowv1.SchemeGroupVersion.Group {
+		return kubeflowSuspendField
+	}
+	return workloadAdapters[u.GroupVersionKind().GroupKind()].suspendField
+}
+
+// kubeflowConditionTypes are the condition types of a Kubeflow job status, which identify the status of a workload
+// as a Kubeflow-style job status, e.g. of a job of KubeDL.
+var kubeflowConditionTypes = sets.New(
+	kubeflowv1.JobCreated,
+	kubeflowv1.JobRunning,
+	kubeflowv1.JobRestarting,
+	kubeflowv1.JobSucceeded,
+	kubeflowv1.JobFailed,
+)
+
+// hasKubeflowConditions reports whether the status of the given workload has conditions of Kubeflow job types.
+func hasKubeflowConditions(u *unstructured.Unstructured) bool {
+	conditions, _, _ := unstructured.NestedSlice(u.Object, "status", "conditions")
+	for _, condition := range conditions {
+		if condition, ok := condition.(map[string]interface{}); ok {
+			if conditionType, ok := condition["type"].(string); ok && kubeflowConditionTypes.Has(kubeflowv1.JobConditionType(conditionType)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getStatusInterpreter returns how the status of the given workload is interpreted. Status rules take precedence
+// over the built-in interpretation of its kind. The status of a workload that is neither a Kubeflow job nor of a
+// kind with a built-in interpretation is interpreted as a Kubeflow job status if it has conditions of Kubeflow job
+// types, like jobs of KubeDL, and following the kstatus conventions otherwise.
+func getStatusInterpreter(u *unstructured.Unstructured, rules *statusrule.Program) v1alpha1.StatusInterpreter {
+	switch {
+	case rules != nil:
+		return v1alpha1.StatusInterpreterStatusRules
+	case getStatusAdapter(u) != nil:
+		return v1alpha1.StatusInterpreterBuiltIn
+	case u.GroupVersionKind().Group == kubeflowv1.SchemeGroupVersion.Group, hasKubeflowConditions(u):
+		return v1alpha1.StatusInterpreterKubeflow
+	default:
+		return v1alpha1.StatusInterpreterKStatus
+	}
+}
@@ -0,0 +1,471 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	kubeflowv1 "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/AliyunContainerService/cron-operator/api/v1alpha1"
+	"github.com/AliyunContainerService/cron-operator/internal/statusrule"
+)
+
+var _ = Describe("Workload status", func() {
+	newWorkload := func(kind string, status map[string]interface{}) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{Object: map[string]interface{}{"status": status}}
+		switch kind {
+		case "Job":
+			u.SetGroupVersionKind(batchv1.SchemeGroupVersion.WithKind("Job"))
+		case "Pod":
+			u.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Pod"))
+		case "TrainJob":
+			u.SetGroupVersionKind(trainJobGVK)
+		case "JobSet":
+			u.SetGroupVersionKind(jobSetGVK)
+		case "RayJob":
+			u.SetGroupVersionKind(rayJobGVK)
+		default:
+			u.SetGroupVersionKind(schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: kind})
+		}
+		return u
+	}
+
+	Context("batch/v1 Job", func() {
+		It("should be active while running", func() {
+			u := newWorkload("Job", map[string]interface{}{
+				"active": int64(1),
+				"conditions": []interface{}{
+					map[string]interface{}{"type": string(batchv1.JobSuccessCriteriaMet), "status": string(corev1.ConditionTrue)},
+				},
+			})
+			cond, finished := isWorkloadFinished(u, nil)
+			Expect(finished).To(BeFalse())
+			Expect(cond).To(Equal(kubeflowv1.JobRunning))
+		})
+
+		It("should succeed once complete", func() {
+			u := newWorkload("Job", map[string]interface{}{
+				"completionTime": "2026-01-01T00:00:00Z",
+				"conditions": []interface{}{
+					map[string]interface{}{"type": string(batchv1.JobSuccessCriteriaMet), "status": string(corev1.ConditionTrue)},
+					map[string]interface{}{"type": string(batchv1.JobComplete), "status": string(corev1.ConditionTrue)},
+				},
+			})
+			cond, finished := isWorkloadFinished(u, nil)
+			Expect(finished).To(BeTrue())
+			Expect(cond).To(Equal(kubeflowv1.JobSucceeded))
+
+			status, err := getJobStatus(u, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.CompletionTime.Time).To(BeTemporally("==", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)))
+		})
+
+		It("should fail once failed", func() {
+			u := newWorkload("Job", map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": string(batchv1.JobFailed), "status": string(corev1.ConditionTrue), "lastTransitionTime": "2026-01-01T00:00:00Z"},
+					map[string]interface{}{"type": string(batchv1.JobSuspended), "status": string(corev1.ConditionFalse)},
+				},
+			})
+			cond, finished := isWorkloadFinished(u, nil)
+			Expect(finished).To(BeTrue())
+			Expect(cond).To(Equal(kubeflowv1.JobFailed))
+
+			status, err := getJobStatus(u, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(getFailedTime(status)).To(BeTemporally("==", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)))
+		})
+	})
+
+	Context("Pod", func() {
+		It("should be active while pending or running", func() {
+			_, finished := isWorkloadFinished(newWorkload("Pod", map[string]interface{}{"phase": string(corev1.PodPending)}), nil)
+			Expect(finished).To(BeFalse())
+
+			cond, finished := isWorkloadFinished(newWorkload("Pod", map[string]interface{}{"phase": string(corev1.PodRunning)}), nil)
+			Expect(finished).To(BeFalse())
+			Expect(cond).To(Equal(kubeflowv1.JobRunning))
+		})
+
+		It("should succeed or fail with its phase", func() {
+			cond, finished := isWorkloadFinished(newWorkload("Pod", map[string]interface{}{"phase": string(corev1.PodSucceeded)}), nil)
+			Expect(finished).To(BeTrue())
+			Expect(cond).To(Equal(kubeflowv1.JobSucceeded))
+
+			cond, finished = isWorkloadFinished(newWorkload("Pod", map[string]interface{}{"phase": string(corev1.PodFailed)}), nil)
+			Expect(finished).To(BeTrue())
+			Expect(cond).To(Equal(kubeflowv1.JobFailed))
+		})
+
+		It("should finish when its last container terminates", func() {
+			u := newWorkload("Pod", map[string]interface{}{
+				"phase": string(corev1.PodFailed),
+				"containerStatuses": []interface{}{
+					map[string]interface{}{"name": "a", "state": map[string]interface{}{"terminated": map[string]interface{}{"exitCode": int64(0), "finishedAt": "2026-01-01T00:00:00Z"}}},
+					map[string]interface{}{"name": "b", "state": map[string]interface{}{"terminated": map[string]interface{}{"exitCode": int64(1), "finishedAt": "2026-01-01T00:01:00Z"}}},
+				},
+			})
+			status, err := getJobStatus(u, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(getFailedTime(status)).To(BeTemporally("==", time.Date(2026, 1, 1, 0, 1, 0, 0, time.UTC)))
+			Expect(status.CompletionTime.Time).To(BeTemporally("==", time.Date(2026, 1, 1, 0, 1, 0, 0, time.UTC)))
+		})
+	})
+
+	Context("TrainJob", func() {
+		It("should be active while any of its Jobs is", func() {
+			u := newWorkload("TrainJob", map[string]interface{}{
+				"jobsStatus": []interface{}{
+					map[string]interface{}{"name": "node", "active": int64(2)},
+				},
+			})
+			cond, finished := isWorkloadFinished(u, nil)
+			Expect(finished).To(BeFalse())
+			Expect(cond).To(Equal(kubeflowv1.JobRunning))
+		})
+
+		It("should succeed once complete", func() {
+			u := newWorkload("TrainJob", map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Complete", "status": "True", "reason": "JobsCompleted", "lastTransitionTime": "2026-01-01T00:00:00Z"},
+					map[string]interface{}{"type": "Suspended", "status": "False", "reason": "Resumed"},
+				},
+				"jobsStatus": []interface{}{
+					map[string]interface{}{"name": "node", "succeeded": int64(2)},
+				},
+			})
+			cond, finished := isWorkloadFinished(u, nil)
+			Expect(finished).To(BeTrue())
+			Expect(cond).To(Equal(kubeflowv1.JobSucceeded))
+
+			status, err := getJobStatus(u, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.CompletionTime.Time).To(BeTemporally("==", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)))
+		})
+
+		It("should fail with the message of its Failed condition", func() {
+			u := newWorkload("TrainJob", map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Failed", "status": "True", "reason": "JobsFailed", "message": "node failed", "lastTransitionTime": "2026-01-01T00:00:00Z"},
+				},
+			})
+			cond, finished := isWorkloadFinished(u, nil)
+			Expect(finished).To(BeTrue())
+			Expect(cond).To(Equal(kubeflowv1.JobFailed))
+
+			status, err := getJobStatus(u, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.Conditions[len(status.Conditions)-1].Message).To(Equal("node failed"))
+			Expect(getFailedTime(status)).To(BeTemporally("==", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)))
+		})
+	})
+
+	Context("JobSet", func() {
+		It("should be active while any of its replicated jobs is", func() {
+			u := newWorkload("JobSet", map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "StartupPolicyCompleted", "status": "True"},
+				},
+				"replicatedJobsStatus": []interface{}{
+					map[string]interface{}{"name": "driver", "active": int64(0), "succeeded": int64(1)},
+					map[string]interface{}{"name": "workers", "active": int64(4)},
+				},
+			})
+			cond, finished := isWorkloadFinished(u, nil)
+			Expect(finished).To(BeFalse())
+			Expect(cond).To(Equal(kubeflowv1.JobRunning))
+		})
+
+		It("should succeed once completed", func() {
+			u := newWorkload("JobSet", map[string]interface{}{
+				"terminalState": "Completed",
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Completed", "status": "True", "reason": "AllJobsCompleted"},
+				},
+			})
+			cond, finished := isWorkloadFinished(u, nil)
+			Expect(finished).To(BeTrue())
+			Expect(cond).To(Equal(kubeflowv1.JobSucceeded))
+		})
+
+		It("should fail with the message of its Failed condition", func() {
+			u := newWorkload("JobSet", map[string]interface{}{
+				"terminalState": "Failed",
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Failed", "status": "True", "reason": "FailedJobs", "message": "jobset failed due to reaching max number of restarts"},
+				},
+			})
+			cond, finished := isWorkloadFinished(u, nil)
+			Expect(finished).To(BeTrue())
+			Expect(cond).To(Equal(kubeflowv1.JobFailed))
+
+			status, err := getJobStatus(u, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.Conditions[len(status.Conditions)-1].Message).To(Equal("jobset failed due to reaching max number of restarts"))
+		})
+	})
+
+	Context("RayJob", func() {
+		It("should be active until its deployment is complete", func() {
+			cond, finished := isWorkloadFinished(newWorkload("RayJob", map[string]interface{}{"jobDeploymentStatus": "Initializing"}), nil)
+			Expect(finished).To(BeFalse())
+			Expect(cond).To(Equal(kubeflowv1.JobCreated))
+
+			cond, finished = isWorkloadFinished(newWorkload("RayJob", map[string]interface{}{
+				"jobDeploymentStatus": "Running",
+				"jobStatus":           "RUNNING",
+			}), nil)
+			Expect(finished).To(BeFalse())
+			Expect(cond).To(Equal(kubeflowv1.JobRunning))
+		})
+
+		It("should succeed once complete with a succeeded Ray job", func() {
+			u := newWorkload("RayJob", map[string]interface{}{
+				"jobDeploymentStatus": "Complete",
+				"jobStatus":           "SUCCEEDED",
+				"endTime":             "2026-01-01T00:00:00Z",
+			})
+			cond, finished := isWorkloadFinished(u, nil)
+			Expect(finished).To(BeTrue())
+			Expect(cond).To(Equal(kubeflowv1.JobSucceeded))
+
+			status, err := getJobStatus(u, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.CompletionTime.Time).To(BeTemporally("==", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)))
+		})
+
+		It("should fail once complete with a failed or stopped Ray job", func() {
+			for _, jobStatus := range []string{"FAILED", "STOPPED"} {
+				cond, finished := isWorkloadFinished(newWorkload("RayJob", map[string]interface{}{
+					"jobDeploymentStatus": "Complete",
+					"jobStatus":           jobStatus,
+				}), nil)
+				Expect(finished).To(BeTrue())
+				Expect(cond).To(Equal(kubeflowv1.JobFailed))
+			}
+		})
+
+		It("should fail with its message once its deployment has failed", func() {
+			u := newWorkload("RayJob", map[string]interface{}{
+				"jobDeploymentStatus": "Failed",
+				"reason":              "DeadlineExceeded",
+				"message":             "The RayJob has passed the activeDeadlineSeconds.",
+				"endTime":             "2026-01-01T00:00:00Z",
+			})
+			status, err := getJobStatus(u, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.Conditions).To(HaveExactElements(And(
+				HaveField("Type", kubeflowv1.JobFailed),
+				HaveField("Reason", "DeadlineExceeded"),
+				HaveField("Message", "The RayJob has passed the activeDeadlineSeconds."),
+			)))
+			Expect(getFailedTime(status)).To(BeTemporally("==", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)))
+		})
+	})
+
+	Context("kstatus", func() {
+		It("should be created until its controller reports a status", func() {
+			cond, finished := isWorkloadFinished(newWorkload("Training", map[string]interface{}{}), nil)
+			Expect(finished).To(BeFalse())
+			Expect(cond).To(Equal(kubeflowv1.JobCreated))
+		})
+
+		It("should be active while in progress", func() {
+			u := newWorkload("Training", map[string]interface{}{
+				"observedGeneration": int64(1),
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": "True", "reason": "Ready"},
+				},
+			})
+			u.SetGeneration(2)
+			cond, finished := isWorkloadFinished(u, nil)
+			Expect(finished).To(BeFalse())
+			Expect(cond).To(Equal(kubeflowv1.JobRunning))
+
+			u = newWorkload("Training", map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": "False", "reason": "Training"},
+				},
+			})
+			cond, finished = isWorkloadFinished(u, nil)
+			Expect(finished).To(BeFalse())
+			Expect(cond).To(Equal(kubeflowv1.JobRunning))
+		})
+
+		It("should be active without a Ready condition", func() {
+			u := newWorkload("Training", map[string]interface{}{
+				"observedGeneration": int64(1),
+			})
+			u.SetGeneration(1)
+			cond, finished := isWorkloadFinished(u, nil)
+			Expect(finished).To(BeFalse())
+			Expect(cond).To(Equal(kubeflowv1.JobRunning))
+
+			u = newWorkload("Training", map[string]interface{}{
+				"observedGeneration": int64(1),
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "CheckpointSaved", "status": "True", "reason": "Saved"},
+				},
+			})
+			u.SetGeneration(1)
+			cond, finished = isWorkloadFinished(u, nil)
+			Expect(finished).To(BeFalse())
+			Expect(cond).To(Equal(kubeflowv1.JobRunning))
+		})
+
+		It("should be active while ready without its latest generation observed", func() {
+			u := newWorkload("Training", map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": "True", "reason": "Done"},
+				},
+			})
+			u.SetGeneration(1)
+			cond, finished := isWorkloadFinished(u, nil)
+			Expect(finished).To(BeFalse())
+			Expect(cond).To(Equal(kubeflowv1.JobRunning))
+
+			// The generation may be observed by the Ready condition itself.
+			u = newWorkload("Training", map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": "True", "reason": "Done", "observedGeneration": int64(1)},
+				},
+			})
+			u.SetGeneration(1)
+			cond, finished = isWorkloadFinished(u, nil)
+			Expect(finished).To(BeTrue())
+			Expect(cond).To(Equal(kubeflowv1.JobSucceeded))
+		})
+
+		It("should succeed once current", func() {
+			u := newWorkload("Training", map[string]interface{}{
+				"observedGeneration": int64(1),
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": "True", "reason": "Done", "lastTransitionTime": "2026-01-01T00:00:00Z"},
+				},
+			})
+			u.SetGeneration(1)
+			cond, finished := isWorkloadFinished(u, nil)
+			Expect(finished).To(BeTrue())
+			Expect(cond).To(Equal(kubeflowv1.JobSucceeded))
+
+			status, err := getJobStatus(u, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.CompletionTime.Time).To(BeTemporally("==", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)))
+		})
+
+		It("should fail with the message of its Stalled condition", func() {
+			u := newWorkload("Training", map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": "False", "reason": "Failed"},
+					map[string]interface{}{"type": "Stalled", "status": "True", "reason": "OutOfMemory", "message": "trainer was OOM killed"},
+				},
+			})
+			status, err := getJobStatus(u, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.Conditions).To(HaveExactElements(And(
+				HaveField("Type", kubeflowv1.JobFailed),
+				HaveField("Reason", "OutOfMemory"),
+				HaveField("Message", "trainer was OOM killed"),
+			)))
+		})
+	})
+
+	Context("getStatusInterpreter", func() {
+		It("should return how the status of each kind is interpreted", func() {
+			pytorchJob := &unstructured.Unstructured{}
+			pytorchJob.SetGroupVersionKind(kubeflowv1.SchemeGroupVersion.WithKind(kubeflowv1.PyTorchJobKind))
+			Expect(getStatusInterpreter(pytorchJob, nil)).To(Equal(v1alpha1.StatusInterpreterKubeflow))
+			Expect(getStatusInterpreter(newWorkload("Job", nil), nil)).To(Equal(v1alpha1.StatusInterpreterBuiltIn))
+			Expect(getStatusInterpreter(newWorkload("RayJob", nil), nil)).To(Equal(v1alpha1.StatusInterpreterBuiltIn))
+			Expect(getStatusInterpreter(newWorkload("Training", nil), nil)).To(Equal(v1alpha1.StatusInterpreterKStatus))
+		})
+
+		It("should interpret Kubeflow-style job statuses of other groups as Kubeflow job statuses", func() {
+			u := newWorkload("TFJob", map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": string(kubeflowv1.JobCreated), "status": "True"},
+					map[string]interface{}{"type": string(kubeflowv1.JobSucceeded), "status": "True"},
+				},
+			})
+			u.SetGroupVersionKind(schema.GroupVersionKind{Group: "training.kubedl.io", Version: "v1alpha1", Kind: "TFJob"})
+			Expect(getStatusInterpreter(u, nil)).To(Equal(v1alpha1.StatusInterpreterKubeflow))
+			cond, finished := isWorkloadFinished(u, nil)
+			Expect(finished).To(BeTrue())
+			Expect(cond).To(Equal(kubeflowv1.JobSucceeded))
+
+			u = newWorkload("Training", map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": "False", "reason": "Training"},
+				},
+			})
+			Expect(getStatusInterpreter(u, nil)).To(Equal(v1alpha1.StatusInterpreterKStatus))
+		})
+
+		It("should prefer status rules", func() {
+			rules, err := statusrule.Compile(v1alpha1.WorkloadStatusRules{Succeeded: "true", Failed: "false"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(getStatusInterpreter(newWorkload("Job", nil), rules)).To(Equal(v1alpha1.StatusInterpreterStatusRules))
+		})
+	})
+
+	Context("Status rules", func() {
+		rules, err := statusrule.Compile(v1alpha1.WorkloadStatusRules{
+			Succeeded: `self.status.state == "Done"`,
+			Failed:    `self.status.state == "Error"`,
+			Running:   `self.status.state == "Training"`,
+			Message:   `"training is " + self.status.state`,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		It("should interpret the status of workloads of any kind", func() {
+			u := &unstructured.Unstructured{Object: map[string]interface{}{"status": map[string]interface{}{"state": "Training"}}}
+			u.SetAPIVersion("example.com/v1")
+			u.SetKind("Training")
+			cond, finished := isWorkloadFinished(u, rules)
+			Expect(finished).To(BeFalse())
+			Expect(cond).To(Equal(kubeflowv1.JobRunning))
+
+			u.Object["status"] = map[string]interface{}{"state": "Error"}
+			status, err := getJobStatus(u, rules)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.Conditions).To(HaveExactElements(And(
+				HaveField("Type", kubeflowv1.JobFailed),
+				HaveField("Message", "training is Error"),
+			)))
+		})
+
+		It("should take precedence over the status adapters", func() {
+			u := newWorkload("Pod", map[string]interface{}{"phase": string(corev1.PodSucceeded), "state": "Training"})
+			cond, finished := isWorkloadFinished(u, rules)
+			Expect(finished).To(BeFalse())
+			Expect(cond).To(Equal(kubeflowv1.JobRunning))
+		})
+
+		It("should fail to interpret workloads the rules do not apply to", func() {
+			_, err := getJobStatus(newWorkload("Pod", map[string]interface{}{}), rules)
+			Expect(err).To(MatchError(ContainSubstring("failed to interpret Pod status with status rules")))
+		})
+	})
+})
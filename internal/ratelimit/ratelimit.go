@@ -0,0 +1,155 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ratelimit limits the rate at which the operator creates workloads, so that a burst of runs,
+// e.g. the missed runs of many Crons after a restart, does not overload the workload operators and
+// admission webhooks.
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// ScopeOperator is the scope of creations throttled by the limit of the whole operator.
+	ScopeOperator = "operator"
+
+	// ScopeNamespace is the scope of creations throttled by the fair share of a namespace.
+	ScopeNamespace = "namespace"
+
+	// pruneInterval is how often the buckets of namespaces that have not created workloads recently are removed.
+	pruneInterval = time.Minute
+)
+
+// throttledCreations counts the workload creations held back by the limiter.
+var throttledCreations = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "cron_operator_workload_creations_throttled_total",
+		Help: "Number of workload creations held back by the operator-wide or per-namespace rate limit.",
+	},
+	[]string{"namespace", "scope"},
+)
+
+func init() {
+	metrics.Registry.MustRegister(throttledCreations)
+}
+
+// Limiter is a token bucket limiting the workload creations of the whole operator, where each namespace
+// is limited to a fair share of the bucket so that a single namespace cannot starve the others.
+// A nil Limiter does not limit creations.
+type Limiter struct {
+	mu sync.Mutex
+
+	operator       *rate.Limiter
+	namespaces     map[string]*rate.Limiter
+	namespaceLimit rate.Limit
+	namespaceBurst int
+	lastPrune      time.Time
+}
+
+// New returns a Limiter allowing qps workload creations per second with the given burst across the
+// operator, and the given share of them in each namespace. It returns nil if qps is not positive.
+func New(qps float64, burst int, namespaceShare float64) *Limiter {
+	if qps <= 0 {
+		return nil
+	}
+	burst = max(burst, 1)
+	namespaceShare = min(max(namespaceShare, 0), 1)
+	if namespaceShare == 0 {
+		namespaceShare = 1
+	}
+	return &Limiter{
+		operator:       rate.NewLimiter(rate.Limit(qps), burst),
+		namespaces:     map[string]*rate.Limiter{},
+		namespaceLimit: rate.Limit(qps * namespaceShare),
+		namespaceBurst: max(int(math.Ceil(float64(burst)*namespaceShare)), 1),
+	}
+}
+
+// Reservation is a token taken to create a workload. A nil Reservation holds no token.
+type Reservation struct {
+	namespace *rate.Reservation
+	operator  *rate.Reservation
+}
+
+// Cancel returns the token to the buckets when no workload has been created with it, e.g. as the creation failed.
+func (r *Reservation) Cancel() {
+	r.cancelAt(time.Now())
+}
+
+func (r *Reservation) cancelAt(now time.Time) {
+	if r == nil {
+		return
+	}
+	r.operator.CancelAt(now)
+	r.namespace.CancelAt(now)
+}
+
+// Reserve takes a token to create a workload in the given namespace and returns it, or returns how long
+// to wait before trying again without taking a token if the creation is throttled.
+func (l *Limiter) Reserve(namespace string) (*Reservation, time.Duration) {
+	return l.reserveAt(namespace, time.Now())
+}
+
+func (l *Limiter) reserveAt(namespace string, now time.Time) (*Reservation, time.Duration) {
+	if l == nil {
+		return nil, 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.prune(now)
+	namespaceLimiter, ok := l.namespaces[namespace]
+	if !ok {
+		namespaceLimiter = rate.NewLimiter(l.namespaceLimit, l.namespaceBurst)
+		l.namespaces[namespace] = namespaceLimiter
+	}
+
+	namespaceReservation := namespaceLimiter.ReserveN(now, 1)
+	if delay := namespaceReservation.DelayFrom(now); delay > 0 {
+		namespaceReservation.CancelAt(now)
+		throttledCreations.WithLabelValues(namespace, ScopeNamespace).Inc()
+		return nil, delay
+	}
+	operatorReservation := l.operator.ReserveN(now, 1)
+	if delay := operatorReservation.DelayFrom(now); delay > 0 {
+		operatorReservation.CancelAt(now)
+		namespaceReservation.CancelAt(now)
+		throttledCreations.WithLabelValues(namespace, ScopeOperator).Inc()
+		return nil, delay
+	}
+	return &Reservation{namespace: namespaceReservation, operator: operatorReservation}, 0
+}
+
+// prune removes the buckets of namespaces that have been refilled, which are equivalent to new ones.
+func (l *Limiter) prune(now time.Time) {
+	if now.Sub(l.lastPrune) < pruneInterval {
+		return
+	}
+	l.lastPrune = now
+	for namespace, namespaceLimiter := range l.namespaces {
+		if namespaceLimiter.TokensAt(now) >= float64(l.namespaceBurst) {
+			delete(l.namespaces, namespace)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var _ = Describe("Limiter", func() {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// delayAt returns how long a creation in the given namespace is throttled at the given time.
+	delayAt := func(l *Limiter, namespace string, t time.Time) time.Duration {
+		_, delay := l.reserveAt(namespace, t)
+		return delay
+	}
+
+	It("should not limit creations if disabled", func() {
+		var l *Limiter
+		Expect(New(0, 10, 0.5)).To(BeNil())
+		for range 100 {
+			Expect(delayAt(l, "ns-1", now)).To(BeZero())
+		}
+	})
+
+	It("should limit the creations of the operator", func() {
+		l := New(1, 2, 1)
+		Expect(delayAt(l, "ns-1", now)).To(BeZero())
+		Expect(delayAt(l, "ns-2", now)).To(BeZero())
+
+		before := testutil.ToFloat64(throttledCreations.WithLabelValues("ns-3", ScopeOperator))
+		Expect(delayAt(l, "ns-3", now)).To(Equal(time.Second))
+		Expect(testutil.ToFloat64(throttledCreations.WithLabelValues("ns-3", ScopeOperator))).To(Equal(before + 1))
+
+		// Throttled creations do not take a token.
+		Expect(delayAt(l, "ns-3", now.Add(time.Second))).To(BeZero())
+	})
+
+	It("should limit the creations of each namespace to its fair share", func() {
+		l := New(2, 4, 0.5)
+		Expect(delayAt(l, "ns-1", now)).To(BeZero())
+		Expect(delayAt(l, "ns-1", now)).To(BeZero())
+
+		before := testutil.ToFloat64(throttledCreations.WithLabelValues("ns-1", ScopeNamespace))
+		Expect(delayAt(l, "ns-1", now)).To(Equal(time.Second))
+		Expect(testutil.ToFloat64(throttledCreations.WithLabelValues("ns-1", ScopeNamespace))).To(Equal(before + 1))
+
+		// Other namespaces still get their share of the operator bucket.
+		Expect(delayAt(l, "ns-2", now)).To(BeZero())
+		Expect(delayAt(l, "ns-2", now)).To(BeZero())
+		Expect(delayAt(l, "ns-2", now)).To(Equal(time.Second))
+	})
+
+	It("should remove the buckets of namespaces that have been refilled", func() {
+		l := New(1, 1, 1)
+		Expect(delayAt(l, "ns-1", now)).To(BeZero())
+		Expect(l.namespaces).To(HaveKey("ns-1"))
+
+		Expect(delayAt(l, "ns-2", now.Add(pruneInterval))).To(BeZero())
+		Expect(l.namespaces).NotTo(HaveKey("ns-1"))
+		Expect(l.namespaces).To(HaveKey("ns-2"))
+	})
+
+	It("should return the token of a canceled reservation", func() {
+		l := New(1, 1, 1)
+		reservation, delay := l.reserveAt("ns-1", now)
+		Expect(delay).To(BeZero())
+		Expect(delayAt(l, "ns-1", now)).To(Equal(time.Second))
+
+		reservation.cancelAt(now)
+		Expect(delayAt(l, "ns-1", now)).To(BeZero())
+		Expect(delayAt(l, "ns-1", now)).To(Equal(time.Second))
+
+		// Reservations of a disabled limiter hold no token.
+		var disabled *Limiter
+		reservation, _ = disabled.reserveAt("ns-1", now)
+		Expect(reservation).To(BeNil())
+		reservation.cancelAt(now)
+	})
+})
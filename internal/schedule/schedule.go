@@ -0,0 +1,193 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package schedule parses Cron schedules into cron schedules evaluated in a specific time zone.
+package schedule
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	cronv3 "github.com/robfig/cron/v3"
+
+	"github.com/AliyunContainerService/cron-operator/api/v1alpha1"
+)
+
+// maxTransitionShift is the largest clock shift of a daylight saving transition
+// in the IANA time zone database.
+const maxTransitionShift = 3 * time.Hour
+
+// LoadLocation returns the location with the given IANA time zone name.
+// The local time zone of the controller is returned if the name is not specified.
+func LoadLocation(timeZone *string) (*time.Location, error) {
+	if timeZone == nil {
+		return time.Local, nil
+	}
+
+	// time.LoadLocation treats an empty name as UTC and "Local" as the controller's
+	// local time zone, neither of which is an IANA time zone name.
+	if *timeZone == "" || *timeZone == "Local" {
+		return nil, fmt.Errorf("unknown time zone %q", *timeZone)
+	}
+
+	loc, err := time.LoadLocation(*timeZone)
+	if err != nil {
+		return nil, fmt.Errorf("unknown time zone %q: %w", *timeZone, err)
+	}
+	return loc, nil
+}
+
+// HasTimeZonePrefix reports whether the cron expression specifies its own time zone
+// with a TZ= or CRON_TZ= prefix.
+func HasTimeZonePrefix(spec string) bool {
+	return strings.HasPrefix(spec, "TZ=") || strings.HasPrefix(spec, "CRON_TZ=")
+}
+
+// secondsParser parses cron expressions like cronv3.ParseStandard, with an optional leading seconds field.
+var secondsParser = cronv3.NewParser(cronv3.SecondOptional | cronv3.Minute | cronv3.Hour | cronv3.Dom | cronv3.Month | cronv3.Dow | cronv3.Descriptor)
+
+// descriptors maps the predefined schedule descriptors to their standard cron expressions.
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Parse parses a cron expression in the given format whose times are evaluated in the given time zone.
+func Parse(spec string, format v1alpha1.ScheduleFormat, timeZone *string) (cronv3.Schedule, error) {
+	loc, err := LoadLocation(timeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	if timeZone != nil && HasTimeZonePrefix(spec) {
+		return nil, fmt.Errorf("TZ and CRON_TZ prefixes are not allowed when time zone is specified")
+	}
+
+	var sched cronv3.Schedule
+	switch format {
+	case v1alpha1.ScheduleFormatStandard, "":
+		sched, err = cronv3.ParseStandard(spec)
+	case v1alpha1.ScheduleFormatWithSeconds:
+		sched, err = secondsParser.Parse(spec)
+	default:
+		return nil, fmt.Errorf("unknown schedule format %q", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	specSched, ok := sched.(*cronv3.SpecSchedule)
+	if !ok {
+		return sched, nil
+	}
+	if timeZone != nil {
+		specSched.Location = loc
+	}
+	return &wallClockSchedule{SpecSchedule: specSched}, nil
+}
+
+// Normalize returns the normalized form of a valid cron expression. Fields are separated by
+// single spaces, descriptors are expanded to their standard cron expressions, and the
+// intervals of @every descriptors are rounded to seconds as they are when scheduled.
+func Normalize(spec string) string {
+	fields := strings.Fields(spec)
+	if len(fields) == 0 {
+		return ""
+	}
+
+	var prefix string
+	if HasTimeZonePrefix(fields[0]) {
+		prefix, fields = fields[0]+" ", fields[1:]
+	}
+
+	switch {
+	case len(fields) == 1 && descriptors[fields[0]] != "":
+		return prefix + descriptors[fields[0]]
+	case len(fields) == 2 && fields[0] == "@every":
+		d, err := time.ParseDuration(fields[1])
+		if err != nil {
+			break
+		}
+		// Intervals are rounded to seconds with a minimum of one second.
+		if d < time.Second {
+			d = time.Second
+		}
+		return prefix + "@every " + (d - d%time.Second).String()
+	}
+	return prefix + strings.Join(fields, " ")
+}
+
+// TimeZone returns the name of the time zone in which the given schedule is evaluated.
+// "Local" is returned for schedules evaluated in the local time zone of the controller.
+func TimeZone(sched cronv3.Schedule) string {
+	switch s := sched.(type) {
+	case *wallClockSchedule:
+		return s.Location.String()
+	case *cronv3.SpecSchedule:
+		return s.Location.String()
+	default:
+		return time.Local.String()
+	}
+}
+
+// wallClockSchedule wraps a cron schedule so that each wall-clock time fires at most once.
+//
+// A wall-clock time repeated by a backward daylight saving transition would be matched
+// twice by the underlying schedule, once before and once after the transition. Only its
+// first occurrence is returned. Times skipped by a forward transition never occur and are
+// therefore never returned.
+type wallClockSchedule struct {
+	*cronv3.SpecSchedule
+}
+
+// Next returns the next activation time later than the given time.
+func (s *wallClockSchedule) Next(t time.Time) time.Time {
+	// The underlying schedule returns times in the location of the given time,
+	// and treats the local time zone as the location of the given time.
+	loc := s.Location
+	if loc == time.Local {
+		loc = t.Location()
+	}
+
+	next := s.SpecSchedule.Next(t)
+	for !next.IsZero() && isRepeatedWallClock(next.In(loc)) {
+		next = s.SpecSchedule.Next(next)
+	}
+	return next
+}
+
+// isRepeatedWallClock reports whether the wall-clock time of t has already occurred
+// before a backward daylight saving transition.
+func isRepeatedWallClock(t time.Time) bool {
+	_, offset := t.Zone()
+	_, offsetBefore := t.Add(-maxTransitionShift).Zone()
+	if offsetBefore <= offset {
+		return false
+	}
+
+	// The same wall-clock time was shown earlier with the offset before the transition.
+	earlier := t.Add(-time.Duration(offsetBefore-offset) * time.Second)
+	if _, offsetEarlier := earlier.Zone(); offsetEarlier != offsetBefore {
+		return false
+	}
+	return earlier.Format(time.DateTime) == t.Format(time.DateTime)
+}
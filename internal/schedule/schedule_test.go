@@ -0,0 +1,142 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	"github.com/AliyunContainerService/cron-operator/api/v1alpha1"
+)
+
+var _ = Describe("Schedule", func() {
+	Context("LoadLocation", func() {
+		It("should return the local time zone if not specified", func() {
+			loc, err := LoadLocation(nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(loc).To(Equal(time.Local))
+		})
+
+		It("should load a valid IANA time zone", func() {
+			loc, err := LoadLocation(ptr.To("Asia/Shanghai"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(loc.String()).To(Equal("Asia/Shanghai"))
+		})
+
+		It("should return error for unknown time zone", func() {
+			_, err := LoadLocation(ptr.To("Mars/Olympus_Mons"))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unknown time zone"))
+		})
+
+		It("should return error for empty and Local time zone", func() {
+			_, err := LoadLocation(ptr.To(""))
+			Expect(err).To(HaveOccurred())
+			_, err = LoadLocation(ptr.To("Local"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("Parse", func() {
+		It("should evaluate the schedule in the given time zone", func() {
+			sched, err := Parse("0 9 * * *", v1alpha1.ScheduleFormatStandard, ptr.To("Asia/Shanghai"))
+			Expect(err).NotTo(HaveOccurred())
+
+			next := sched.Next(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+			Expect(next.UTC()).To(Equal(time.Date(2026, 1, 1, 1, 0, 0, 0, time.UTC)))
+			Expect(TimeZone(sched)).To(Equal("Asia/Shanghai"))
+		})
+
+		It("should evaluate the schedule in the local time zone if not specified", func() {
+			sched, err := Parse("0 9 * * *", v1alpha1.ScheduleFormatStandard, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(TimeZone(sched)).To(Equal("Local"))
+		})
+
+		It("should return error if the schedule has a time zone prefix", func() {
+			_, err := Parse("CRON_TZ=UTC 0 9 * * *", v1alpha1.ScheduleFormatStandard, ptr.To("Asia/Shanghai"))
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should return error for invalid schedule", func() {
+			_, err := Parse("60 * * * *", v1alpha1.ScheduleFormatStandard, nil)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should skip wall-clock times skipped by a forward transition", func() {
+			sched, err := Parse("30 2 * * *", v1alpha1.ScheduleFormatStandard, ptr.To("America/New_York"))
+			Expect(err).NotTo(HaveOccurred())
+
+			// Clocks jump from 02:00 to 03:00 on 2026-03-08.
+			next := sched.Next(time.Date(2026, 3, 7, 12, 0, 0, 0, time.UTC))
+			Expect(next.UTC()).To(Equal(time.Date(2026, 3, 9, 6, 30, 0, 0, time.UTC)))
+		})
+
+		It("should fire wall-clock times repeated by a backward transition only once", func() {
+			sched, err := Parse("30 1 * * *", v1alpha1.ScheduleFormatStandard, ptr.To("America/New_York"))
+			Expect(err).NotTo(HaveOccurred())
+
+			// Clocks fall back from 02:00 to 01:00 on 2026-11-01.
+			next := sched.Next(time.Date(2026, 10, 31, 12, 0, 0, 0, time.UTC))
+			Expect(next.UTC()).To(Equal(time.Date(2026, 11, 1, 5, 30, 0, 0, time.UTC)))
+			next = sched.Next(next)
+			Expect(next.UTC()).To(Equal(time.Date(2026, 11, 2, 6, 30, 0, 0, time.UTC)))
+		})
+
+		It("should return error for seconds field in standard format", func() {
+			_, err := Parse("30 0 9 * * *", v1alpha1.ScheduleFormatStandard, nil)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should parse seconds field in format with seconds", func() {
+			sched, err := Parse("30 0 9 * * *", v1alpha1.ScheduleFormatWithSeconds, ptr.To("UTC"))
+			Expect(err).NotTo(HaveOccurred())
+
+			next := sched.Next(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+			Expect(next.UTC()).To(Equal(time.Date(2026, 1, 1, 9, 0, 30, 0, time.UTC)))
+		})
+
+		It("should parse five-field schedule, descriptors and intervals in both formats", func() {
+			for _, format := range []v1alpha1.ScheduleFormat{v1alpha1.ScheduleFormatStandard, v1alpha1.ScheduleFormatWithSeconds} {
+				for _, spec := range []string{"0 9 * * *", "@daily", "@every 90s"} {
+					_, err := Parse(spec, format, nil)
+					Expect(err).NotTo(HaveOccurred(), spec)
+				}
+			}
+		})
+
+		It("should return error for unknown format", func() {
+			_, err := Parse("0 9 * * *", "Quartz", nil)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("Normalize", func() {
+		It("should normalize cron expressions", func() {
+			Expect(Normalize(" 0  9 * *   * ")).To(Equal("0 9 * * *"))
+			Expect(Normalize("30 0 9 * * *")).To(Equal("30 0 9 * * *"))
+			Expect(Normalize("@hourly")).To(Equal("0 * * * *"))
+			Expect(Normalize("@annually")).To(Equal("0 0 1 1 *"))
+			Expect(Normalize("@every 90s")).To(Equal("@every 1m30s"))
+			Expect(Normalize("@every 1500ms")).To(Equal("@every 1s"))
+			Expect(Normalize("CRON_TZ=UTC @daily")).To(Equal("CRON_TZ=UTC 0 0 * * *"))
+		})
+	})
+})
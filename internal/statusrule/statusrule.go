@@ -0,0 +1,275 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package statusrule interprets the status of workloads with CEL expressions, so that workloads of any kind
+// can be scheduled by configuring how their status is read instead of changing the operator.
+package statusrule
+
+import (
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/yaml"
+
+	"github.com/AliyunContainerService/cron-operator/api/v1alpha1"
+)
+
+const (
+	// costLimit is the maximum runtime cost of evaluating an expression, which bounds the time spent
+	// on expressions iterating over large workloads.
+	costLimit = 1000000
+
+	// maxOverrides is the maximum number of compiled status rules of Crons kept for reuse.
+	maxOverrides = 1000
+)
+
+// newEnv returns the CEL environment of status rules, where the workload is available as `self`.
+var newEnv = sync.OnceValues(func() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable("self", cel.DynType),
+		ext.Strings(),
+	)
+})
+
+// Status is the status of a workload interpreted by status rules.
+type Status struct {
+	Succeeded bool
+	Failed    bool
+	Running   bool
+	Message   string
+}
+
+// Program is a set of compiled status rules.
+type Program struct {
+	succeeded cel.Program
+	failed    cel.Program
+	running   cel.Program
+	message   cel.Program
+}
+
+// expression is an expression of status rules.
+type expression struct {
+	name       string
+	source     string
+	outputType *cel.Type
+	required   bool
+}
+
+// expressions returns the expressions of the given status rules.
+func expressions(rules v1alpha1.WorkloadStatusRules) []expression {
+	return []expression{
+		{"succeeded", rules.Succeeded, cel.BoolType, true},
+		{"failed", rules.Failed, cel.BoolType, true},
+		{"running", rules.Running, cel.BoolType, false},
+		{"message", rules.Message, cel.StringType, false},
+	}
+}
+
+// Compile compiles the given status rules.
+func Compile(rules v1alpha1.WorkloadStatusRules) (*Program, error) {
+	env, err := newEnv()
+	if err != nil {
+		return nil, err
+	}
+	p := &Program{}
+	programs := []*cel.Program{&p.succeeded, &p.failed, &p.running, &p.message}
+	for i, expr := range expressions(rules) {
+		if expr.source == "" {
+			if expr.required {
+				return nil, fmt.Errorf("%s must be specified", expr.name)
+			}
+			continue
+		}
+		if *programs[i], err = compile(env, expr.source, expr.outputType); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", expr.name, err)
+		}
+	}
+	return p, nil
+}
+
+// Validate validates the given status rules, which must specify succeeded and failed, and all of whose
+// expressions must compile to values of the expected types.
+func Validate(rules v1alpha1.WorkloadStatusRules, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	env, err := newEnv()
+	if err != nil {
+		return append(allErrs, field.InternalError(fldPath, err))
+	}
+	for _, expr := range expressions(rules) {
+		if expr.source == "" {
+			if expr.required {
+				allErrs = append(allErrs, field.Required(fldPath.Child(expr.name), "must be specified"))
+			}
+			continue
+		}
+		if _, err := compile(env, expr.source, expr.outputType); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(expr.name), expr.source, err.Error()))
+		}
+	}
+	return allErrs
+}
+
+// compile compiles an expression returning a value of the given type. Expressions whose type is only
+// known at runtime, e.g. fields of the workload, are checked when they are evaluated.
+func compile(env *cel.Env, source string, outputType *cel.Type) (cel.Program, error) {
+	ast, issues := env.Compile(source)
+	if issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if t := ast.OutputType(); !t.IsExactType(outputType) && !t.IsExactType(cel.DynType) {
+		return nil, fmt.Errorf("must return a %s, not a %s", outputType, t)
+	}
+	return env.Program(ast, cel.CostLimit(costLimit))
+}
+
+// Evaluate interprets the status of the given workload. Failed takes precedence over succeeded.
+func (p *Program) Evaluate(workload map[string]interface{}) (Status, error) {
+	activation := map[string]interface{}{"self": workload}
+	status := Status{}
+	var err error
+	if status.Failed, err = evaluate[bool](p.failed, activation); err != nil {
+		return Status{}, fmt.Errorf("failed to evaluate failed: %w", err)
+	}
+	if !status.Failed {
+		if status.Succeeded, err = evaluate[bool](p.succeeded, activation); err != nil {
+			return Status{}, fmt.Errorf("failed to evaluate succeeded: %w", err)
+		}
+	}
+	if !status.Failed && !status.Succeeded {
+		if status.Running, err = evaluate[bool](p.running, activation); err != nil {
+			return Status{}, fmt.Errorf("failed to evaluate running: %w", err)
+		}
+	}
+	if status.Message, err = evaluate[string](p.message, activation); err != nil {
+		return Status{}, fmt.Errorf("failed to evaluate message: %w", err)
+	}
+	return status, nil
+}
+
+// evaluate evaluates a compiled expression, which returns the zero value if it is not specified.
+func evaluate[T bool | string](program cel.Program, activation map[string]interface{}) (T, error) {
+	var result T
+	if program == nil {
+		return result, nil
+	}
+	val, _, err := program.Eval(activation)
+	if err != nil {
+		return result, err
+	}
+	result, ok := val.Value().(T)
+	if !ok {
+		return result, fmt.Errorf("returned a %s instead of a %T", val.Type().TypeName(), result)
+	}
+	return result, nil
+}
+
+// KindRules are the status rules of a kind of workloads in the operator configuration.
+type KindRules struct {
+	// APIVersion is the API version of the kind, of which only the group is matched.
+	APIVersion string `json:"apiVersion"`
+	// Kind is the kind of the workloads.
+	Kind string `json:"kind"`
+
+	v1alpha1.WorkloadStatusRules `json:",inline"`
+}
+
+// Config is the operator configuration of status rules.
+type Config struct {
+	// Rules are the status rules of workload kinds.
+	Rules []KindRules `json:"rules"`
+}
+
+// Set holds the compiled status rules of workload kinds in the operator configuration, and the ones of Crons
+// overriding them. A nil Set has no status rules of kinds and does not reuse the ones of Crons.
+type Set struct {
+	kinds map[schema.GroupKind]*Program
+
+	mu        sync.Mutex
+	overrides map[v1alpha1.WorkloadStatusRules]*Program
+}
+
+// NewSet compiles the given status rules of workload kinds.
+func NewSet(config Config) (*Set, error) {
+	s := &Set{
+		kinds:     map[schema.GroupKind]*Program{},
+		overrides: map[v1alpha1.WorkloadStatusRules]*Program{},
+	}
+	for _, rules := range config.Rules {
+		gv, err := schema.ParseGroupVersion(rules.APIVersion)
+		if err != nil {
+			return nil, fmt.Errorf("invalid apiVersion of %s status rules: %w", rules.Kind, err)
+		}
+		if rules.Kind == "" {
+			return nil, fmt.Errorf("status rules of %s have no kind", rules.APIVersion)
+		}
+		gk := gv.WithKind(rules.Kind).GroupKind()
+		if _, ok := s.kinds[gk]; ok {
+			return nil, fmt.Errorf("duplicate status rules of %s", gk)
+		}
+		if s.kinds[gk], err = Compile(rules.WorkloadStatusRules); err != nil {
+			return nil, fmt.Errorf("invalid status rules of %s: %w", gk, err)
+		}
+	}
+	return s, nil
+}
+
+// Load reads and compiles the status rules of workload kinds in the given configuration file.
+func Load(path string) (*Set, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	config := Config{}
+	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return NewSet(config)
+}
+
+// Get returns the compiled status rules of the workloads of the given kind, which are the given rules
+// of a Cron if not nil, or the rules of the kind in the operator configuration otherwise.
+// It returns nil if there are neither.
+func (s *Set) Get(gk schema.GroupKind, override *v1alpha1.WorkloadStatusRules) (*Program, error) {
+	if override == nil {
+		if s == nil {
+			return nil, nil
+		}
+		return s.kinds[gk], nil
+	}
+	if s == nil {
+		return Compile(*override)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if p, ok := s.overrides[*override]; ok {
+		return p, nil
+	}
+	p, err := Compile(*override)
+	if err != nil {
+		return nil, err
+	}
+	if len(s.overrides) >= maxOverrides {
+		clear(s.overrides)
+	}
+	s.overrides[*override] = p
+	return p, nil
+}
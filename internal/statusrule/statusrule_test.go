@@ -0,0 +1,174 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statusrule
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/AliyunContainerService/cron-operator/api/v1alpha1"
+)
+
+var _ = Describe("Status rules", func() {
+	rules := v1alpha1.WorkloadStatusRules{
+		Succeeded: `has(self.status.phase) && self.status.phase == "Done"`,
+		Failed:    `has(self.status.phase) && self.status.phase == "Error"`,
+		Running:   `has(self.status.phase) && self.status.phase == "Training"`,
+		Message:   `has(self.status.message) ? self.status.message : ""`,
+	}
+	workload := func(status map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"apiVersion": "example.com/v1",
+			"kind":       "Training",
+			"status":     status,
+		}
+	}
+
+	Context("Compile", func() {
+		It("should interpret the status of a workload", func() {
+			p, err := Compile(rules)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(p.Evaluate(workload(map[string]interface{}{}))).To(Equal(Status{}))
+			Expect(p.Evaluate(workload(map[string]interface{}{"phase": "Training"}))).To(Equal(Status{Running: true}))
+			Expect(p.Evaluate(workload(map[string]interface{}{"phase": "Done"}))).To(Equal(Status{Succeeded: true}))
+			Expect(p.Evaluate(workload(map[string]interface{}{"phase": "Error", "message": "out of memory"}))).
+				To(Equal(Status{Failed: true, Message: "out of memory"}))
+		})
+
+		It("should give failed precedence over succeeded", func() {
+			p, err := Compile(v1alpha1.WorkloadStatusRules{Succeeded: "true", Failed: "self.status.failed > 0"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(p.Evaluate(workload(map[string]interface{}{"failed": int64(1)}))).To(Equal(Status{Failed: true}))
+			Expect(p.Evaluate(workload(map[string]interface{}{"failed": int64(0)}))).To(Equal(Status{Succeeded: true}))
+		})
+
+		It("should reject invalid rules", func() {
+			_, err := Compile(v1alpha1.WorkloadStatusRules{Succeeded: "true"})
+			Expect(err).To(MatchError(ContainSubstring("failed must be specified")))
+
+			_, err = Compile(v1alpha1.WorkloadStatusRules{Succeeded: "self.status.", Failed: "false"})
+			Expect(err).To(MatchError(ContainSubstring("invalid succeeded")))
+
+			_, err = Compile(v1alpha1.WorkloadStatusRules{Succeeded: "true", Failed: "false", Message: "1 + 1"})
+			Expect(err).To(MatchError(ContainSubstring("must return a string, not a int")))
+		})
+
+		It("should fail to evaluate expressions returning another type", func() {
+			p, err := Compile(v1alpha1.WorkloadStatusRules{Succeeded: "self.status.phase", Failed: "false"})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = p.Evaluate(workload(map[string]interface{}{"phase": "Done"}))
+			Expect(err).To(MatchError(ContainSubstring("returned a string instead of a bool")))
+		})
+
+		It("should fail to evaluate absent fields", func() {
+			p, err := Compile(v1alpha1.WorkloadStatusRules{Succeeded: `self.status.phase == "Done"`, Failed: "false"})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = p.Evaluate(workload(map[string]interface{}{}))
+			Expect(err).To(MatchError(ContainSubstring("no such key: phase")))
+		})
+	})
+
+	Context("Validate", func() {
+		It("should report each invalid expression", func() {
+			errs := Validate(v1alpha1.WorkloadStatusRules{Failed: "self.", Running: `"yes"`}, nil)
+			Expect(errs).To(HaveLen(3))
+			Expect(errs[0].Field).To(Equal("succeeded"))
+			Expect(errs[1].Field).To(Equal("failed"))
+			Expect(errs[2].Field).To(Equal("running"))
+		})
+	})
+
+	Context("Set", func() {
+		gk := schema.GroupKind{Group: "example.com", Kind: "Training"}
+
+		It("should load the status rules of kinds", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "rules.yaml")
+			Expect(os.WriteFile(path, []byte(`rules:
+- apiVersion: example.com/v1
+  kind: Training
+  succeeded: self.status.phase == "Done"
+  failed: self.status.phase == "Error"
+`), 0o600)).To(Succeed())
+
+			s, err := Load(path)
+			Expect(err).NotTo(HaveOccurred())
+			p, err := s.Get(gk, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(p.Evaluate(workload(map[string]interface{}{"phase": "Done"}))).To(Equal(Status{Succeeded: true}))
+
+			p, err = s.Get(schema.GroupKind{Group: "example.com", Kind: "Other"}, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(p).To(BeNil())
+		})
+
+		It("should reject invalid configurations", func() {
+			_, err := NewSet(Config{Rules: []KindRules{
+				{APIVersion: "example.com/v1", Kind: "Training", WorkloadStatusRules: rules},
+				{APIVersion: "example.com/v2", Kind: "Training", WorkloadStatusRules: rules},
+			}})
+			Expect(err).To(MatchError(ContainSubstring("duplicate status rules of Training.example.com")))
+
+			_, err = NewSet(Config{Rules: []KindRules{{APIVersion: "example.com/v1", Kind: "Training"}}})
+			Expect(err).To(MatchError(ContainSubstring("invalid status rules of Training.example.com")))
+		})
+
+		It("should prefer and reuse the status rules of Crons", func() {
+			s, err := NewSet(Config{Rules: []KindRules{{APIVersion: "example.com/v1", Kind: "Training", WorkloadStatusRules: rules}}})
+			Expect(err).NotTo(HaveOccurred())
+
+			override := &v1alpha1.WorkloadStatusRules{Succeeded: "true", Failed: "false"}
+			p, err := s.Get(gk, override)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(p.Evaluate(workload(map[string]interface{}{}))).To(Equal(Status{Succeeded: true}))
+
+			again, err := s.Get(gk, override.DeepCopy())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(again).To(BeIdenticalTo(p))
+		})
+
+		It("should reuse the status rules of Crons without rules of kinds", func() {
+			s, err := NewSet(Config{})
+			Expect(err).NotTo(HaveOccurred())
+
+			p, err := s.Get(gk, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(p).To(BeNil())
+
+			p, err = s.Get(gk, &rules)
+			Expect(err).NotTo(HaveOccurred())
+			again, err := s.Get(gk, rules.DeepCopy())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(again).To(BeIdenticalTo(p))
+		})
+
+		It("should only compile the status rules of Crons without a set", func() {
+			var s *Set
+			p, err := s.Get(gk, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(p).To(BeNil())
+
+			p, err = s.Get(gk, &rules)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(p).NotTo(BeNil())
+		})
+	})
+})
@@ -0,0 +1,192 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/AliyunContainerService/cron-operator/api/v1alpha1"
+	"github.com/AliyunContainerService/cron-operator/internal/schedule"
+	"github.com/AliyunContainerService/cron-operator/internal/statusrule"
+)
+
+// SetupCronWebhookWithManager registers the webhook for Cron in the manager.
+func SetupCronWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&v1alpha1.Cron{}).
+		WithValidator(&CronCustomValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-apps-kubedl-io-v1alpha1-cron,mutating=false,failurePolicy=fail,sideEffects=None,groups=apps.kubedl.io,resources=crons,verbs=create;update,versions=v1alpha1,name=vcron-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// CronCustomValidator validates Cron resources when they are created or updated.
+type CronCustomValidator struct{}
+
+// CronCustomValidator implements webhook.CustomValidator.
+var _ webhook.CustomValidator = &CronCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator.
+func (v *CronCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	cron, ok := obj.(*v1alpha1.Cron)
+	if !ok {
+		return nil, fmt.Errorf("expected a Cron object but got %T", obj)
+	}
+	logf.FromContext(ctx).V(1).Info("Validating Cron creation", "name", cron.GetName())
+
+	return nil, validateCron(cron)
+}
+
+// ValidateUpdate implements webhook.CustomValidator.
+func (v *CronCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	cron, ok := newObj.(*v1alpha1.Cron)
+	if !ok {
+		return nil, fmt.Errorf("expected a Cron object for the newObj but got %T", newObj)
+	}
+	logf.FromContext(ctx).V(1).Info("Validating Cron update", "name", cron.GetName())
+
+	return nil, validateCron(cron)
+}
+
+// ValidateDelete implements webhook.CustomValidator.
+func (v *CronCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateCron validates the spec of the given Cron.
+func validateCron(cron *v1alpha1.Cron) error {
+	allErrs := validateCronSpec(&cron.Spec, field.NewPath("spec"))
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(v1alpha1.GroupVersion.WithKind(v1alpha1.KindCron).GroupKind(), cron.Name, allErrs)
+}
+
+// validateCronSpec validates the given Cron spec: its time zone, schedules and status rules, which can only be
+// checked by parsing them, and its jitter, deadline, concurrency, run timeout, replace and retry policy fields,
+// which are also checked by the validation rules of the CRD when the webhook is disabled.
+func validateCronSpec(spec *v1alpha1.CronSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if spec.TimeZone != nil {
+		if _, err := schedule.LoadLocation(spec.TimeZone); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeZone"), *spec.TimeZone, err.Error()))
+		}
+	}
+	// Schedules can only be parsed in a valid time zone.
+	validTimeZone := len(allErrs) == 0
+
+	if spec.Jitter != nil && spec.Jitter.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("jitter"), spec.Jitter.Duration.String(), "must be non-negative"))
+	}
+
+	if spec.StartTime != nil && spec.Deadline != nil && !spec.Deadline.After(spec.StartTime.Time) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("deadline"), spec.Deadline.String(), "must be after startTime"))
+	}
+
+	if spec.MaxActiveRuns != nil && spec.ConcurrencyPolicy != "" && spec.ConcurrencyPolicy != v1alpha1.ConcurrentPolicyAllow {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("maxActiveRuns"), "may only be specified with the Allow concurrency policy"))
+	}
+
+	if spec.ConcurrencyGroupLimit != nil && spec.ConcurrencyGroup == "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("concurrencyGroupLimit"), "may only be specified with a concurrency group"))
+	}
+
+	if spec.RunTimeout != nil && spec.RunTimeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("runTimeout"), spec.RunTimeout.Duration.String(), "must be positive"))
+	}
+
+	if policy := spec.ReplacePolicy; policy != nil && policy.Timeout != nil && policy.Timeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("replacePolicy", "timeout"), policy.Timeout.Duration.String(), "must be positive"))
+	}
+
+	if policy := spec.RetryPolicy; policy != nil {
+		policyPath := fldPath.Child("retryPolicy")
+		if policy.Backoff != nil && policy.Backoff.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(policyPath.Child("backoff"), policy.Backoff.Duration.String(), "must be positive"))
+		}
+		if policy.MaxBackoff != nil && policy.MaxBackoff.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(policyPath.Child("maxBackoff"), policy.MaxBackoff.Duration.String(), "must be positive"))
+		}
+	}
+
+	if rules := spec.Template.StatusRules; rules != nil {
+		allErrs = append(allErrs, statusrule.Validate(*rules, fldPath.Child("template", "statusRules"))...)
+	}
+
+	switch {
+	case spec.Schedule != "" && len(spec.Schedules) > 0:
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("schedules"), "may not be specified together with schedule"))
+	case spec.Schedule == "" && len(spec.Schedules) == 0:
+		allErrs = append(allErrs, field.Required(fldPath.Child("schedule"), "one of schedule and schedules must be specified"))
+	case spec.Schedule != "":
+		if validTimeZone {
+			allErrs = append(allErrs, validateSchedule(spec.Schedule, spec.ScheduleFormat, spec.TimeZone, fldPath.Child("schedule"))...)
+		}
+	default:
+		names := sets.New[string]()
+		for i, s := range spec.Schedules {
+			idxPath := fldPath.Child("schedules").Index(i)
+			// Schedules that are not named are identified by their index in the list.
+			name := s.Name
+			if name == "" {
+				name = strconv.Itoa(i)
+			} else {
+				for _, msg := range validation.IsDNS1123Label(s.Name) {
+					allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), s.Name, msg))
+				}
+			}
+			if names.Has(name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), name))
+			}
+			names.Insert(name)
+			if validTimeZone {
+				allErrs = append(allErrs, validateSchedule(s.Schedule, spec.ScheduleFormat, spec.TimeZone, idxPath.Child("schedule"))...)
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// validateSchedule validates a cron schedule expression in the given format and time zone.
+func validateSchedule(spec string, format v1alpha1.ScheduleFormat, timeZone *string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if timeZone != nil && schedule.HasTimeZonePrefix(spec) {
+		allErrs = append(allErrs, field.Invalid(fldPath, spec, "TZ and CRON_TZ prefixes are not allowed when timeZone is specified"))
+		return allErrs
+	}
+
+	if _, err := schedule.Parse(spec, format, timeZone); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, spec, err.Error()))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,245 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/AliyunContainerService/cron-operator/api/v1alpha1"
+)
+
+var _ = Describe("Cron Webhook", func() {
+	var (
+		ctx       context.Context
+		validator *CronCustomValidator
+		cron      *v1alpha1.Cron
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		validator = &CronCustomValidator{}
+		cron = &v1alpha1.Cron{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cron-test",
+				Namespace: "default",
+			},
+			Spec: v1alpha1.CronSpec{
+				Schedule: "*/1 * * * *",
+				Template: v1alpha1.CronTemplateSpec{
+					Workload: &runtime.RawExtension{
+						Raw: []byte(`{"apiVersion":"kubeflow.org/v1","kind":"PyTorchJob"}`),
+					},
+				},
+			},
+		}
+	})
+
+	Context("When creating or updating Cron under Validating Webhook", func() {
+		It("should admit a valid Cron", func() {
+			_, err := validator.ValidateCreate(ctx, cron)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should admit a Cron with a valid time zone", func() {
+			cron.Spec.TimeZone = ptr.To("Europe/Berlin")
+			_, err := validator.ValidateCreate(ctx, cron)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should deny a Cron with an invalid schedule", func() {
+			cron.Spec.Schedule = "60 * * * *"
+			_, err := validator.ValidateCreate(ctx, cron)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.schedule"))
+		})
+
+		It("should deny a Cron with an unknown time zone", func() {
+			cron.Spec.TimeZone = ptr.To("Europe/Atlantis")
+			_, err := validator.ValidateCreate(ctx, cron)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.timeZone"))
+		})
+
+		It("should deny a Cron with both time zone and TZ prefix in schedule", func() {
+			cron.Spec.TimeZone = ptr.To("Europe/Berlin")
+			cron.Spec.Schedule = "TZ=UTC 0 * * * *"
+			_, err := validator.ValidateCreate(ctx, cron)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.schedule"))
+		})
+
+		It("should admit a Cron with multiple schedules", func() {
+			cron.Spec.Schedule = ""
+			cron.Spec.Schedules = []v1alpha1.CronSchedule{
+				{Name: "weekdays", Schedule: "0 2 * * 1-5"},
+				{Name: "weekends", Schedule: "0 6 * * 0,6"},
+				{Schedule: "0 12 1 * *"},
+			}
+			_, err := validator.ValidateCreate(ctx, cron)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should deny a Cron with both schedule and schedules", func() {
+			cron.Spec.Schedules = []v1alpha1.CronSchedule{{Schedule: "0 2 * * *"}}
+			_, err := validator.ValidateCreate(ctx, cron)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.schedules"))
+		})
+
+		It("should deny a Cron without schedule", func() {
+			cron.Spec.Schedule = ""
+			_, err := validator.ValidateCreate(ctx, cron)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.schedule"))
+		})
+
+		It("should deny a Cron with duplicate schedule names", func() {
+			cron.Spec.Schedule = ""
+			cron.Spec.Schedules = []v1alpha1.CronSchedule{
+				{Schedule: "0 2 * * *"},
+				{Name: "0", Schedule: "0 6 * * *"},
+			}
+			_, err := validator.ValidateCreate(ctx, cron)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.schedules[1].name"))
+		})
+
+		It("should deny a Cron with an invalid schedule in schedules", func() {
+			cron.Spec.Schedule = ""
+			cron.Spec.Schedules = []v1alpha1.CronSchedule{
+				{Name: "daily", Schedule: "0 2 * * *"},
+				{Name: "hourly", Schedule: "60 * * * *"},
+			}
+			_, err := validator.ValidateCreate(ctx, cron)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.schedules[1].schedule"))
+		})
+
+		It("should deny a Cron with seconds field in standard format", func() {
+			cron.Spec.Schedule = "30 */5 * * * *"
+			_, err := validator.ValidateCreate(ctx, cron)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.schedule"))
+		})
+
+		It("should admit a Cron with seconds field and intervals in format with seconds", func() {
+			cron.Spec.ScheduleFormat = v1alpha1.ScheduleFormatWithSeconds
+			cron.Spec.Schedule = ""
+			cron.Spec.Schedules = []v1alpha1.CronSchedule{
+				{Schedule: "30 */5 * * * *"},
+				{Schedule: "@every 90s"},
+				{Schedule: "@weekly"},
+			}
+			_, err := validator.ValidateCreate(ctx, cron)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should deny a Cron with a negative jitter", func() {
+			cron.Spec.Jitter = &metav1.Duration{Duration: -time.Minute}
+			_, err := validator.ValidateCreate(ctx, cron)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.jitter"))
+		})
+
+		It("should admit a Cron with a start time before its deadline", func() {
+			startTime := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+			cron.Spec.StartTime = &metav1.Time{Time: startTime}
+			cron.Spec.Deadline = &metav1.Time{Time: startTime.Add(24 * time.Hour)}
+			_, err := validator.ValidateCreate(ctx, cron)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should deny a Cron with a deadline not after its start time", func() {
+			startTime := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+			cron.Spec.StartTime = &metav1.Time{Time: startTime}
+			cron.Spec.Deadline = &metav1.Time{Time: startTime}
+			_, err := validator.ValidateCreate(ctx, cron)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.deadline"))
+		})
+
+		It("should deny a Cron with max active runs and a concurrency policy other than Allow", func() {
+			cron.Spec.MaxActiveRuns = ptr.To[int32](3)
+			cron.Spec.ConcurrencyPolicy = v1alpha1.ConcurrentPolicyForbid
+			_, err := validator.ValidateCreate(ctx, cron)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.maxActiveRuns"))
+		})
+
+		It("should deny a Cron with a concurrency group limit but no concurrency group", func() {
+			cron.Spec.ConcurrencyGroupLimit = ptr.To[int32](2)
+			_, err := validator.ValidateCreate(ctx, cron)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.concurrencyGroupLimit"))
+		})
+
+		It("should deny a Cron with invalid status rules", func() {
+			cron.Spec.Template.StatusRules = &v1alpha1.WorkloadStatusRules{
+				Succeeded: `self.status.phase == "Done"`,
+				Failed:    "self.status.phase",
+				Message:   "self.status.message",
+			}
+			_, err := validator.ValidateCreate(ctx, cron)
+			Expect(err).NotTo(HaveOccurred())
+
+			cron.Spec.Template.StatusRules.Succeeded = "self.status.("
+			_, err = validator.ValidateCreate(ctx, cron)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.template.statusRules.succeeded"))
+		})
+
+		It("should deny a Cron with a non-positive run timeout", func() {
+			cron.Spec.RunTimeout = &metav1.Duration{Duration: -time.Hour}
+			_, err := validator.ValidateCreate(ctx, cron)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.runTimeout"))
+		})
+
+		It("should deny a Cron with a non-positive replace timeout", func() {
+			cron.Spec.ReplacePolicy = &v1alpha1.CronReplacePolicy{Timeout: &metav1.Duration{}}
+			_, err := validator.ValidateCreate(ctx, cron)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.replacePolicy.timeout"))
+		})
+
+		It("should deny a Cron with a non-positive retry backoff", func() {
+			cron.Spec.RetryPolicy = &v1alpha1.CronRetryPolicy{
+				MaxRetries: 3,
+				Backoff:    &metav1.Duration{},
+			}
+			_, err := validator.ValidateCreate(ctx, cron)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.retryPolicy.backoff"))
+		})
+
+		It("should deny an update to an unknown time zone", func() {
+			newCron := cron.DeepCopy()
+			newCron.Spec.TimeZone = ptr.To("Local")
+			_, err := validator.ValidateUpdate(ctx, cron, newCron)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+		})
+	})
+})
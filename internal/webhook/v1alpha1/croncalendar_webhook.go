@@ -0,0 +1,142 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/AliyunContainerService/cron-operator/api/v1alpha1"
+	"github.com/AliyunContainerService/cron-operator/internal/calendar"
+	"github.com/AliyunContainerService/cron-operator/internal/schedule"
+)
+
+// SetupCronCalendarWebhookWithManager registers the webhook for CronCalendar in the manager.
+func SetupCronCalendarWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&v1alpha1.CronCalendar{}).
+		WithValidator(&CronCalendarCustomValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-apps-kubedl-io-v1alpha1-croncalendar,mutating=false,failurePolicy=fail,sideEffects=None,groups=apps.kubedl.io,resources=croncalendars,verbs=create;update,versions=v1alpha1,name=vcroncalendar-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// CronCalendarCustomValidator validates CronCalendar resources when they are created or updated.
+type CronCalendarCustomValidator struct{}
+
+// CronCalendarCustomValidator implements webhook.CustomValidator.
+var _ webhook.CustomValidator = &CronCalendarCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator.
+func (v *CronCalendarCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	cal, ok := obj.(*v1alpha1.CronCalendar)
+	if !ok {
+		return nil, fmt.Errorf("expected a CronCalendar object but got %T", obj)
+	}
+	logf.FromContext(ctx).V(1).Info("Validating CronCalendar creation", "name", cal.GetName())
+
+	return nil, validateCronCalendar(cal)
+}
+
+// ValidateUpdate implements webhook.CustomValidator.
+func (v *CronCalendarCustomValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	cal, ok := newObj.(*v1alpha1.CronCalendar)
+	if !ok {
+		return nil, fmt.Errorf("expected a CronCalendar object for the newObj but got %T", newObj)
+	}
+	logf.FromContext(ctx).V(1).Info("Validating CronCalendar update", "name", cal.GetName())
+
+	return nil, validateCronCalendar(cal)
+}
+
+// ValidateDelete implements webhook.CustomValidator.
+func (v *CronCalendarCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateCronCalendar validates the spec of the given CronCalendar.
+func validateCronCalendar(cal *v1alpha1.CronCalendar) error {
+	allErrs := validateCronCalendarSpec(&cal.Spec, field.NewPath("spec"))
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(v1alpha1.GroupVersion.WithKind(v1alpha1.KindCronCalendar).GroupKind(), cal.Name, allErrs)
+}
+
+// validateWindowSchedule validates the cron schedule expression of a recurring window in the given time zone.
+func validateWindowSchedule(spec string, timeZone *string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if timeZone != nil && schedule.HasTimeZonePrefix(spec) {
+		allErrs = append(allErrs, field.Invalid(fldPath, spec, "TZ and CRON_TZ prefixes are not allowed when timeZone is specified"))
+		return allErrs
+	}
+
+	if _, err := calendar.ParseWindowSchedule(spec, timeZone); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, spec, err.Error()))
+	}
+
+	return allErrs
+}
+
+// validateCronCalendarSpec validates the excluded dates and time windows of the given CronCalendar spec.
+func validateCronCalendarSpec(spec *v1alpha1.CronCalendarSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if spec.TimeZone != nil {
+		if _, err := schedule.LoadLocation(spec.TimeZone); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeZone"), *spec.TimeZone, err.Error()))
+		}
+	}
+	// Window schedules can only be parsed in a valid time zone.
+	validTimeZone := len(allErrs) == 0
+
+	for i, d := range spec.ExcludedDates {
+		if _, err := calendar.ParseDate(d.Date); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("excludedDates").Index(i).Child("date"), d.Date, err.Error()))
+		}
+	}
+
+	for i, w := range spec.ExcludedWindows {
+		idxPath := fldPath.Child("excludedWindows").Index(i)
+		switch {
+		case w.Start != nil && w.End != nil && w.Schedule == "" && w.Duration == nil:
+			if !w.End.After(w.Start.Time) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("end"), w.End.String(), "must be after start"))
+			}
+		case w.Start == nil && w.End == nil && w.Schedule != "" && w.Duration != nil:
+			if w.Duration.Duration <= 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("duration"), w.Duration.Duration.String(), "must be positive"))
+			}
+			if validTimeZone {
+				allErrs = append(allErrs, validateWindowSchedule(w.Schedule, spec.TimeZone, idxPath.Child("schedule"))...)
+			}
+		default:
+			allErrs = append(allErrs, field.Invalid(idxPath, "", "exactly one of start and end, or schedule and duration must be specified"))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/AliyunContainerService/cron-operator/api/v1alpha1"
+)
+
+var _ = Describe("CronCalendar Webhook", func() {
+	var (
+		ctx       context.Context
+		validator *CronCalendarCustomValidator
+		cal       *v1alpha1.CronCalendar
+		start     time.Time
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		validator = &CronCalendarCustomValidator{}
+		start = time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+		cal = &v1alpha1.CronCalendar{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "calendar-test",
+				Namespace: "default",
+			},
+			Spec: v1alpha1.CronCalendarSpec{
+				TimeZone: ptr.To("Asia/Shanghai"),
+				ExcludedDates: []v1alpha1.CronCalendarDate{
+					{Date: "2026-10-01", Reason: "National Day"},
+					{Date: "12-25"},
+				},
+				ExcludedWindows: []v1alpha1.CronCalendarWindow{
+					{Start: &metav1.Time{Time: start}, End: &metav1.Time{Time: start.Add(time.Hour)}},
+					{Schedule: "0 22 * * 6", Duration: &metav1.Duration{Duration: 4 * time.Hour}},
+				},
+			},
+		}
+	})
+
+	Context("When creating or updating CronCalendar under Validating Webhook", func() {
+		It("should admit a valid CronCalendar", func() {
+			_, err := validator.ValidateCreate(ctx, cal)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should deny a CronCalendar with an unknown time zone", func() {
+			cal.Spec.TimeZone = ptr.To("Europe/Atlantis")
+			_, err := validator.ValidateCreate(ctx, cal)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.timeZone"))
+		})
+
+		It("should deny a CronCalendar with an invalid date", func() {
+			cal.Spec.ExcludedDates[1].Date = "02-30"
+			_, err := validator.ValidateCreate(ctx, cal)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.excludedDates[1].date"))
+		})
+
+		It("should deny a window ending before it starts", func() {
+			cal.Spec.ExcludedWindows[0].End = &metav1.Time{Time: start.Add(-time.Hour)}
+			_, err := validator.ValidateCreate(ctx, cal)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.excludedWindows[0].end"))
+		})
+
+		It("should deny a recurring window with an invalid schedule", func() {
+			cal.Spec.ExcludedWindows[1].Schedule = "0 25 * * *"
+			_, err := validator.ValidateCreate(ctx, cal)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.excludedWindows[1].schedule"))
+		})
+
+		It("should deny a recurring window with an interval schedule", func() {
+			cal.Spec.ExcludedWindows[1].Schedule = "@every 24h"
+			_, err := validator.ValidateCreate(ctx, cal)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.excludedWindows[1].schedule"))
+		})
+
+		It("should deny a recurring window with a non-positive duration", func() {
+			cal.Spec.ExcludedWindows[1].Duration = &metav1.Duration{}
+			_, err := validator.ValidateCreate(ctx, cal)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.excludedWindows[1].duration"))
+		})
+
+		It("should deny an update mixing one-time and recurring windows", func() {
+			newCal := cal.DeepCopy()
+			newCal.Spec.ExcludedWindows[0].Schedule = "0 0 * * *"
+			_, err := validator.ValidateUpdate(ctx, cal, newCal)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.excludedWindows[0]"))
+		})
+	})
+})
@@ -22,4 +22,31 @@ const (
 
 	// LabelCronName is the label for cron name.
 	LabelCronName = LabelPrefixKubeDL + "/cron-name"
+
+	// LabelCronSchedule is the label for the name of the cron schedule that created a workload
+	// when the cron has multiple schedules.
+	LabelCronSchedule = LabelPrefixKubeDL + "/cron-schedule"
+
+	// LabelCronBackfillName is the label for the name of the cron backfill that created a workload.
+	LabelCronBackfillName = LabelPrefixKubeDL + "/cron-backfill-name"
+
+	// AnnotationCronScheduleTime is the annotation for the scheduled time of a workload in RFC 3339 format.
+	AnnotationCronScheduleTime = LabelPrefixKubeDL + "/cron-schedule-time"
+
+	// AnnotationTrigger is the annotation to trigger a run of a cron on demand. Each time it is set
+	// to a new token, e.g. a timestamp, the cron creates one run immediately.
+	AnnotationTrigger = "apps.kubedl.io/trigger"
+
+	// AnnotationCronTrigger is the annotation for the trigger token of a workload created on demand.
+	AnnotationCronTrigger = LabelPrefixKubeDL + "/cron-trigger"
+
+	// AnnotationCronRetryOf is the annotation for the name of the original failed run retried by a workload.
+	AnnotationCronRetryOf = LabelPrefixKubeDL + "/cron-retry-of"
+
+	// AnnotationCronRetryAttempt is the annotation for the number of the retry of the original run, starting at 1.
+	AnnotationCronRetryAttempt = LabelPrefixKubeDL + "/cron-retry-attempt"
+
+	// AnnotationCronTimedOut is the annotation for the time in RFC 3339 format when a workload was suspended
+	// for exceeding the run timeout of its cron.
+	AnnotationCronTimedOut = LabelPrefixKubeDL + "/cron-timed-out"
 )